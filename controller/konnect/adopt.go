@@ -0,0 +1,156 @@
+package konnect
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	configurationv1beta1 "github.com/kong/kubernetes-configuration/api/configuration/v1beta1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+// labelSelectorFromMetadata turns the given Kubernetes-origin labels into a
+// Konnect label-filter selector string ("k=v,k=v,...").
+func labelSelectorFromMetadata(labels map[string]string) string {
+	kv := make([]string, 0, len(labels))
+	for k, v := range labels {
+		kv = append(kv, fmt.Sprintf("%s=%s", k, v))
+	}
+	return strings.Join(kv, ",")
+}
+
+// adoptControlPlane looks up an existing KonnectControlPlane in Konnect by
+// its stable k8s-uid label and, if found, adopts it by storing its Konnect
+// ID on ent instead of failing the reconcile with a conflict.
+func adoptControlPlane(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *konnectv1alpha1.KonnectControlPlane) error {
+	resp, err := sdk.ControlPlanes.ListControlPlanes(ctx, sdkkonnectops.ListControlPlanesRequest{
+		Labels: sdkkonnectgo.String(labelSelectorFromMetadata(stableIdentityLabels(ent))),
+	})
+	if err != nil {
+		return &ErrConflict{Body: err.Error()}
+	}
+	if resp.ListControlPlanesResponse != nil {
+		for _, cp := range resp.ListControlPlanesResponse.Data {
+			if uid, ok := UIDFromLabels(cp.Labels); ok && uid == string(ent.GetUID()) {
+				ent.SetKonnectID(cp.ID)
+				return nil
+			}
+		}
+	}
+	return &ErrConflict{Body: "no matching control plane found in Konnect to adopt"}
+}
+
+// adoptService looks up an existing KongService in Konnect by its k8s-uid
+// tag and, if found, adopts it by storing its Konnect ID on ent instead of
+// failing the reconcile with a conflict.
+func adoptService(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1alpha1.KongService) error {
+	resp, err := sdk.Services.ListService(ctx, sdkkonnectops.ListServiceRequest{
+		ControlPlaneID: ent.GetKonnectStatus().GetControlPlaneID(),
+		Tags:           sdkkonnectgo.String(stableIdentityTag(ent)),
+	})
+	if err != nil {
+		return &ErrConflict{Body: err.Error()}
+	}
+	if resp.Object != nil {
+		for _, svc := range resp.Object.Data {
+			if uid, ok := UIDFromTags(svc.Tags); ok && uid == string(ent.GetUID()) {
+				ent.SetKonnectID(*svc.ID)
+				return nil
+			}
+		}
+	}
+	return &ErrConflict{Body: "no matching service found in Konnect to adopt"}
+}
+
+// adoptRoute looks up an existing KongRoute in Konnect by its k8s-uid tag
+// and, if found, adopts it by storing its Konnect ID on ent instead of
+// failing the reconcile with a conflict.
+func adoptRoute(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1alpha1.KongRoute) error {
+	resp, err := sdk.Routes.ListRoute(ctx, sdkkonnectops.ListRouteRequest{
+		ControlPlaneID: ent.GetKonnectStatus().GetControlPlaneID(),
+		Tags:           sdkkonnectgo.String(stableIdentityTag(ent)),
+	})
+	if err != nil {
+		return &ErrConflict{Body: err.Error()}
+	}
+	if resp.Object != nil {
+		for _, route := range resp.Object.Data {
+			if uid, ok := UIDFromTags(route.Tags); ok && uid == string(ent.GetUID()) {
+				ent.SetKonnectID(*route.ID)
+				return nil
+			}
+		}
+	}
+	return &ErrConflict{Body: "no matching route found in Konnect to adopt"}
+}
+
+// adoptConsumer looks up an existing KongConsumer in Konnect by its k8s-uid
+// tag and, if found, adopts it by storing its Konnect ID on ent instead of
+// failing the reconcile with a conflict.
+func adoptConsumer(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1.KongConsumer) error {
+	resp, err := sdk.Consumers.ListConsumer(ctx, sdkkonnectops.ListConsumerRequest{
+		ControlPlaneID: ent.GetKonnectStatus().GetControlPlaneID(),
+		Tags:           sdkkonnectgo.String(stableIdentityTag(ent)),
+	})
+	if err != nil {
+		return &ErrConflict{Body: err.Error()}
+	}
+	if resp.Object != nil {
+		for _, consumer := range resp.Object.Data {
+			if uid, ok := UIDFromTags(consumer.Tags); ok && uid == string(ent.GetUID()) {
+				ent.SetKonnectID(*consumer.ID)
+				return nil
+			}
+		}
+	}
+	return &ErrConflict{Body: "no matching consumer found in Konnect to adopt"}
+}
+
+// adoptUpstream looks up an existing KongUpstream in Konnect by its k8s-uid
+// tag and, if found, adopts it by storing its Konnect ID on ent instead of
+// failing the reconcile with a conflict.
+func adoptUpstream(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1alpha1.KongUpstream) error {
+	resp, err := sdk.Upstreams.ListUpstream(ctx, sdkkonnectops.ListUpstreamRequest{
+		ControlPlaneID: ent.GetKonnectStatus().GetControlPlaneID(),
+		Tags:           sdkkonnectgo.String(stableIdentityTag(ent)),
+	})
+	if err != nil {
+		return &ErrConflict{Body: err.Error()}
+	}
+	if resp.Object != nil {
+		for _, upstream := range resp.Object.Data {
+			if uid, ok := UIDFromTags(upstream.Tags); ok && uid == string(ent.GetUID()) {
+				ent.SetKonnectID(*upstream.ID)
+				return nil
+			}
+		}
+	}
+	return &ErrConflict{Body: "no matching upstream found in Konnect to adopt"}
+}
+
+// adoptConsumerGroup looks up an existing KongConsumerGroup in Konnect by
+// its k8s-uid tag and, if found, adopts it by storing its Konnect ID on ent
+// instead of failing the reconcile with a conflict.
+func adoptConsumerGroup(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1beta1.KongConsumerGroup) error {
+	resp, err := sdk.ConsumerGroups.ListConsumerGroup(ctx, sdkkonnectops.ListConsumerGroupRequest{
+		ControlPlaneID: ent.GetKonnectStatus().GetControlPlaneID(),
+		Tags:           sdkkonnectgo.String(stableIdentityTag(ent)),
+	})
+	if err != nil {
+		return &ErrConflict{Body: err.Error()}
+	}
+	if resp.Object != nil {
+		for _, cg := range resp.Object.Data {
+			if uid, ok := UIDFromTags(cg.Tags); ok && uid == string(ent.GetUID()) {
+				ent.SetKonnectID(*cg.ID)
+				return nil
+			}
+		}
+	}
+	return &ErrConflict{Body: "no matching consumer group found in Konnect to adopt"}
+}
@@ -80,3 +80,31 @@ const (
 	// condition type indicating that the KongService reference is invalid.
 	KongServiceRefReasonInvalid = "Invalid"
 )
+
+const (
+	// KongUpstreamRefValidConditionType is the type of the condition that indicates
+	// whether the KongUpstream reference (e.g. on a KongTarget) is valid and
+	// points to an existing KongUpstream.
+	KongUpstreamRefValidConditionType = "KongUpstreamRefValid"
+
+	// KongUpstreamRefReasonValid is the reason used with the KongUpstreamRefValid
+	// condition type indicating that the KongUpstream reference is valid.
+	KongUpstreamRefReasonValid = "Valid"
+	// KongUpstreamRefReasonInvalid is the reason used with the KongUpstreamRefValid
+	// condition type indicating that the KongUpstream reference is invalid.
+	KongUpstreamRefReasonInvalid = "Invalid"
+)
+
+const (
+	// KongConsumerRefValidConditionType is the type of the condition that indicates
+	// whether the KongConsumer reference on a consumer credential (BasicAuth,
+	// APIKey, HMAC, ...) is valid and points to an existing KongConsumer.
+	KongConsumerRefValidConditionType = "KongConsumerRefValid"
+
+	// KongConsumerRefReasonValid is the reason used with the KongConsumerRefValid
+	// condition type indicating that the KongConsumer reference is valid.
+	KongConsumerRefReasonValid = "Valid"
+	// KongConsumerRefReasonInvalid is the reason used with the KongConsumerRefValid
+	// condition type indicating that the KongConsumer reference is invalid.
+	KongConsumerRefReasonInvalid = "Invalid"
+)
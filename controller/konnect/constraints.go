@@ -17,7 +17,12 @@ type SupportedKonnectEntityType interface {
 		configurationv1alpha1.KongService |
 		configurationv1alpha1.KongRoute |
 		configurationv1.KongConsumer |
-		configurationv1beta1.KongConsumerGroup
+		configurationv1beta1.KongConsumerGroup |
+		configurationv1alpha1.KongUpstream |
+		configurationv1alpha1.KongTarget |
+		configurationv1alpha1.KongCredentialBasicAuth |
+		configurationv1alpha1.KongCredentialAPIKey |
+		configurationv1alpha1.KongCredentialHMAC
 	// TODO: add other types
 
 	GetTypeName() string
@@ -0,0 +1,49 @@
+package konnect
+
+import (
+	"context"
+
+	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+)
+
+// createConsumer creates a KongConsumer in Konnect.
+// It sets the KonnectID in the KongConsumer status.
+func createConsumer(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1.KongConsumer) error {
+	resp, err := sdk.Consumers.CreateConsumer(ctx, ent.Status.Konnect.ControlPlaneID, kongConsumerToSDKConsumerInput(ent))
+	if errWrap := wrapErrIfKonnectOpFailed[configurationv1.KongConsumer, *configurationv1.KongConsumer](err, CreateOp, ent); errWrap != nil {
+		return errWrap
+	}
+
+	ent.Status.Konnect.SetKonnectID(*resp.Consumer.ID)
+	return nil
+}
+
+// updateConsumer updates a KongConsumer in Konnect.
+// The Konnect ID of the KongConsumer must be set on its Status.
+func updateConsumer(ctx context.Context, sdk *sdkkonnectgo.SDK, cl client.Client, ent *configurationv1.KongConsumer) error {
+	_, err := sdk.Consumers.UpsertConsumer(ctx, sdkkonnectops.UpsertConsumerRequest{
+		ControlPlaneID: ent.Status.Konnect.ControlPlaneID,
+		ConsumerID:     ent.Status.Konnect.GetKonnectID(),
+		Consumer:       kongConsumerToSDKConsumerInput(ent),
+	})
+	return wrapErrIfKonnectOpFailed[configurationv1.KongConsumer, *configurationv1.KongConsumer](err, UpdateOp, ent)
+}
+
+// deleteConsumer deletes a KongConsumer in Konnect.
+// The Konnect ID of the KongConsumer must be set on its Status.
+func deleteConsumer(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1.KongConsumer) error {
+	_, err := sdk.Consumers.DeleteConsumer(ctx, ent.Status.Konnect.ControlPlaneID, ent.Status.Konnect.GetKonnectID())
+	return wrapErrIfKonnectOpFailed[configurationv1.KongConsumer, *configurationv1.KongConsumer](err, DeleteOp, ent)
+}
+
+func kongConsumerToSDKConsumerInput(ent *configurationv1.KongConsumer) sdkkonnectcomp.Consumer {
+	return sdkkonnectcomp.Consumer{
+		Username: sdkkonnectgo.String(ent.Username),
+		Tags:     GenerateKubernetesMetadataTags(ent),
+	}
+}
@@ -0,0 +1,49 @@
+package konnect
+
+import (
+	"context"
+
+	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1beta1 "github.com/kong/kubernetes-configuration/api/configuration/v1beta1"
+)
+
+// createConsumerGroup creates a KongConsumerGroup in Konnect.
+// It sets the KonnectID in the KongConsumerGroup status.
+func createConsumerGroup(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1beta1.KongConsumerGroup) error {
+	resp, err := sdk.ConsumerGroups.CreateConsumerGroup(ctx, ent.Status.Konnect.ControlPlaneID, kongConsumerGroupToSDKConsumerGroupInput(ent))
+	if errWrap := wrapErrIfKonnectOpFailed[configurationv1beta1.KongConsumerGroup, *configurationv1beta1.KongConsumerGroup](err, CreateOp, ent); errWrap != nil {
+		return errWrap
+	}
+
+	ent.Status.Konnect.SetKonnectID(*resp.ConsumerGroup.ID)
+	return nil
+}
+
+// updateConsumerGroup updates a KongConsumerGroup in Konnect.
+// The Konnect ID of the KongConsumerGroup must be set on its Status.
+func updateConsumerGroup(ctx context.Context, sdk *sdkkonnectgo.SDK, cl client.Client, ent *configurationv1beta1.KongConsumerGroup) error {
+	_, err := sdk.ConsumerGroups.UpsertConsumerGroup(ctx, sdkkonnectops.UpsertConsumerGroupRequest{
+		ControlPlaneID:  ent.Status.Konnect.ControlPlaneID,
+		ConsumerGroupID: ent.Status.Konnect.GetKonnectID(),
+		ConsumerGroup:   kongConsumerGroupToSDKConsumerGroupInput(ent),
+	})
+	return wrapErrIfKonnectOpFailed[configurationv1beta1.KongConsumerGroup, *configurationv1beta1.KongConsumerGroup](err, UpdateOp, ent)
+}
+
+// deleteConsumerGroup deletes a KongConsumerGroup in Konnect.
+// The Konnect ID of the KongConsumerGroup must be set on its Status.
+func deleteConsumerGroup(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1beta1.KongConsumerGroup) error {
+	_, err := sdk.ConsumerGroups.DeleteConsumerGroup(ctx, ent.Status.Konnect.ControlPlaneID, ent.Status.Konnect.GetKonnectID())
+	return wrapErrIfKonnectOpFailed[configurationv1beta1.KongConsumerGroup, *configurationv1beta1.KongConsumerGroup](err, DeleteOp, ent)
+}
+
+func kongConsumerGroupToSDKConsumerGroupInput(ent *configurationv1beta1.KongConsumerGroup) sdkkonnectcomp.ConsumerGroup {
+	return sdkkonnectcomp.ConsumerGroup{
+		Name: ent.Name,
+		Tags: GenerateKubernetesMetadataTags(ent),
+	}
+}
@@ -0,0 +1,50 @@
+package konnect
+
+import (
+	"context"
+
+	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+// createControlPlane creates a KonnectControlPlane in Konnect.
+// It sets the KonnectID in the KonnectControlPlane status.
+func createControlPlane(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *konnectv1alpha1.KonnectControlPlane) error {
+	resp, err := sdk.ControlPlanes.CreateControlPlane(ctx, konnectControlPlaneToSDKCreateControlPlaneRequest(ent))
+	if errWrap := wrapErrIfKonnectOpFailed[konnectv1alpha1.KonnectControlPlane, *konnectv1alpha1.KonnectControlPlane](err, CreateOp, ent); errWrap != nil {
+		return errWrap
+	}
+
+	ent.Status.Konnect.SetKonnectID(resp.ControlPlane.ID)
+	return nil
+}
+
+// updateControlPlane updates a KonnectControlPlane in Konnect.
+// The Konnect ID of the KonnectControlPlane must be set on its Status.
+func updateControlPlane(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *konnectv1alpha1.KonnectControlPlane) error {
+	_, err := sdk.ControlPlanes.UpdateControlPlane(ctx, ent.Status.Konnect.GetKonnectID(), konnectControlPlaneToSDKUpdateControlPlaneRequest(ent))
+	return wrapErrIfKonnectOpFailed[konnectv1alpha1.KonnectControlPlane, *konnectv1alpha1.KonnectControlPlane](err, UpdateOp, ent)
+}
+
+// deleteControlPlane deletes a KonnectControlPlane in Konnect.
+// The Konnect ID of the KonnectControlPlane must be set on its Status.
+func deleteControlPlane(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *konnectv1alpha1.KonnectControlPlane) error {
+	_, err := sdk.ControlPlanes.DeleteControlPlane(ctx, ent.Status.Konnect.GetKonnectID())
+	return wrapErrIfKonnectOpFailed[konnectv1alpha1.KonnectControlPlane, *konnectv1alpha1.KonnectControlPlane](err, DeleteOp, ent)
+}
+
+func konnectControlPlaneToSDKCreateControlPlaneRequest(ent *konnectv1alpha1.KonnectControlPlane) sdkkonnectcomp.CreateControlPlaneRequest {
+	return sdkkonnectcomp.CreateControlPlaneRequest{
+		Name:   ent.Spec.Name,
+		Labels: GenerateKubernetesMetadataLabels(ent),
+	}
+}
+
+func konnectControlPlaneToSDKUpdateControlPlaneRequest(ent *konnectv1alpha1.KonnectControlPlane) sdkkonnectcomp.UpdateControlPlaneRequest {
+	return sdkkonnectcomp.UpdateControlPlaneRequest{
+		Name:   sdkkonnectgo.String(ent.Spec.Name),
+		Labels: GenerateKubernetesMetadataLabels(ent),
+	}
+}
@@ -0,0 +1,71 @@
+package konnect
+
+import (
+	"context"
+
+	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+)
+
+// createCredentialAPIKey creates a KongCredentialAPIKey in Konnect, under
+// the KongConsumer referenced by ent.Spec.ConsumerRef.
+// It sets the KonnectID and the ConsumerID in the status.
+func createCredentialAPIKey(ctx context.Context, sdk *sdkkonnectgo.SDK, cl client.Client, ent *configurationv1alpha1.KongCredentialAPIKey) error {
+	consumerID, err := handleKongConsumerRef(ctx, cl, ent, ent.Namespace, ent.Spec.ConsumerRef.Name)
+	if err != nil {
+		return err
+	}
+	ent.Status.Konnect.ConsumerID = consumerID
+
+	resp, err := sdk.KeyAuthCredentials.CreateKeyAuthWithConsumer(ctx, sdkkonnectops.CreateKeyAuthWithConsumerRequest{
+		ControlPlaneID:            ent.Status.Konnect.ControlPlaneID,
+		ConsumerIDForNestedEntity: ent.Status.Konnect.ConsumerID,
+		KeyAuthWithoutParents:     kongCredentialAPIKeyToSDK(ent),
+	})
+	if errWrap := wrapErrIfKonnectOpFailed[configurationv1alpha1.KongCredentialAPIKey, *configurationv1alpha1.KongCredentialAPIKey](err, CreateOp, ent); errWrap != nil {
+		return errWrap
+	}
+
+	ent.Status.Konnect.SetKonnectID(*resp.KeyAuth.ID)
+	return nil
+}
+
+// updateCredentialAPIKey updates a KongCredentialAPIKey in Konnect.
+// The Konnect ID of the credential must be set on its Status.
+func updateCredentialAPIKey(ctx context.Context, sdk *sdkkonnectgo.SDK, cl client.Client, ent *configurationv1alpha1.KongCredentialAPIKey) error {
+	_, err := sdk.KeyAuthCredentials.UpsertKeyAuthWithConsumer(ctx, sdkkonnectops.UpsertKeyAuthWithConsumerRequest{
+		ControlPlaneID:            ent.Status.Konnect.ControlPlaneID,
+		ConsumerIDForNestedEntity: ent.Status.Konnect.ConsumerID,
+		KeyAuthID:                 ent.Status.Konnect.GetKonnectID(),
+		KeyAuthWithoutParents:     kongCredentialAPIKeyToSDK(ent),
+	})
+	return wrapErrIfKonnectOpFailed[configurationv1alpha1.KongCredentialAPIKey, *configurationv1alpha1.KongCredentialAPIKey](err, UpdateOp, ent)
+}
+
+// deleteCredentialAPIKey deletes a KongCredentialAPIKey in Konnect.
+// The Konnect ID of the credential must be set on its Status and passed as
+// KeyAuthID: using the wrong ID field name here (e.g. BasicAuthID) silently
+// no-ops against Konnect instead of deleting the intended credential.
+func deleteCredentialAPIKey(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1alpha1.KongCredentialAPIKey) error {
+	_, err := sdk.KeyAuthCredentials.DeleteKeyAuthWithConsumer(ctx, deleteCredentialAPIKeyRequest(ent))
+	return wrapErrIfKonnectOpFailed[configurationv1alpha1.KongCredentialAPIKey, *configurationv1alpha1.KongCredentialAPIKey](err, DeleteOp, ent)
+}
+
+func deleteCredentialAPIKeyRequest(ent *configurationv1alpha1.KongCredentialAPIKey) sdkkonnectops.DeleteKeyAuthWithConsumerRequest {
+	return sdkkonnectops.DeleteKeyAuthWithConsumerRequest{
+		ControlPlaneID:            ent.Status.Konnect.ControlPlaneID,
+		ConsumerIDForNestedEntity: ent.Status.Konnect.ConsumerID,
+		KeyAuthID:                 ent.Status.Konnect.GetKonnectID(),
+	}
+}
+
+func kongCredentialAPIKeyToSDK(ent *configurationv1alpha1.KongCredentialAPIKey) sdkkonnectcomp.KeyAuthWithoutParents {
+	return sdkkonnectcomp.KeyAuthWithoutParents{
+		Key:  sdkkonnectgo.String(ent.Spec.Key),
+		Tags: GenerateKubernetesMetadataTags(ent),
+	}
+}
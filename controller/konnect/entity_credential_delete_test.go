@@ -0,0 +1,41 @@
+package konnect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+)
+
+// TestDeleteCredentialRequests_IDFieldPopulated guards against copy-paste
+// mistakes between the three credential types: passing e.g. KeyAuthID where
+// BasicAuthID is expected silently no-ops against Konnect instead of
+// deleting the intended credential.
+func TestDeleteCredentialRequests_IDFieldPopulated(t *testing.T) {
+	const konnectID = "test-konnect-id"
+
+	t.Run("BasicAuth", func(t *testing.T) {
+		ent := &configurationv1alpha1.KongCredentialBasicAuth{}
+		ent.Status.Konnect.SetKonnectID(konnectID)
+
+		req := deleteCredentialBasicAuthRequest(ent)
+		assert.Equal(t, konnectID, req.BasicAuthID)
+	})
+
+	t.Run("APIKey", func(t *testing.T) {
+		ent := &configurationv1alpha1.KongCredentialAPIKey{}
+		ent.Status.Konnect.SetKonnectID(konnectID)
+
+		req := deleteCredentialAPIKeyRequest(ent)
+		assert.Equal(t, konnectID, req.KeyAuthID)
+	})
+
+	t.Run("HMAC", func(t *testing.T) {
+		ent := &configurationv1alpha1.KongCredentialHMAC{}
+		ent.Status.Konnect.SetKonnectID(konnectID)
+
+		req := deleteCredentialHMACRequest(ent)
+		assert.Equal(t, konnectID, req.HMACAuthID)
+	})
+}
@@ -0,0 +1,72 @@
+package konnect
+
+import (
+	"context"
+
+	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+)
+
+// createCredentialHMAC creates a KongCredentialHMAC in Konnect, under the
+// KongConsumer referenced by ent.Spec.ConsumerRef.
+// It sets the KonnectID and the ConsumerID in the status.
+func createCredentialHMAC(ctx context.Context, sdk *sdkkonnectgo.SDK, cl client.Client, ent *configurationv1alpha1.KongCredentialHMAC) error {
+	consumerID, err := handleKongConsumerRef(ctx, cl, ent, ent.Namespace, ent.Spec.ConsumerRef.Name)
+	if err != nil {
+		return err
+	}
+	ent.Status.Konnect.ConsumerID = consumerID
+
+	resp, err := sdk.HMACAuthCredentials.CreateHmacAuthWithConsumer(ctx, sdkkonnectops.CreateHmacAuthWithConsumerRequest{
+		ControlPlaneID:            ent.Status.Konnect.ControlPlaneID,
+		ConsumerIDForNestedEntity: ent.Status.Konnect.ConsumerID,
+		HMACAuthWithoutParents:    kongCredentialHMACToSDK(ent),
+	})
+	if errWrap := wrapErrIfKonnectOpFailed[configurationv1alpha1.KongCredentialHMAC, *configurationv1alpha1.KongCredentialHMAC](err, CreateOp, ent); errWrap != nil {
+		return errWrap
+	}
+
+	ent.Status.Konnect.SetKonnectID(*resp.HMACAuth.ID)
+	return nil
+}
+
+// updateCredentialHMAC updates a KongCredentialHMAC in Konnect.
+// The Konnect ID of the credential must be set on its Status.
+func updateCredentialHMAC(ctx context.Context, sdk *sdkkonnectgo.SDK, cl client.Client, ent *configurationv1alpha1.KongCredentialHMAC) error {
+	_, err := sdk.HMACAuthCredentials.UpsertHmacAuthWithConsumer(ctx, sdkkonnectops.UpsertHmacAuthWithConsumerRequest{
+		ControlPlaneID:            ent.Status.Konnect.ControlPlaneID,
+		ConsumerIDForNestedEntity: ent.Status.Konnect.ConsumerID,
+		HMACAuthID:                ent.Status.Konnect.GetKonnectID(),
+		HMACAuthWithoutParents:    kongCredentialHMACToSDK(ent),
+	})
+	return wrapErrIfKonnectOpFailed[configurationv1alpha1.KongCredentialHMAC, *configurationv1alpha1.KongCredentialHMAC](err, UpdateOp, ent)
+}
+
+// deleteCredentialHMAC deletes a KongCredentialHMAC in Konnect.
+// The Konnect ID of the credential must be set on its Status and passed as
+// HMACAuthID: using the wrong ID field name here (e.g. KeyAuthID) silently
+// no-ops against Konnect instead of deleting the intended credential.
+func deleteCredentialHMAC(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1alpha1.KongCredentialHMAC) error {
+	_, err := sdk.HMACAuthCredentials.DeleteHmacAuthWithConsumer(ctx, deleteCredentialHMACRequest(ent))
+	return wrapErrIfKonnectOpFailed[configurationv1alpha1.KongCredentialHMAC, *configurationv1alpha1.KongCredentialHMAC](err, DeleteOp, ent)
+}
+
+func deleteCredentialHMACRequest(ent *configurationv1alpha1.KongCredentialHMAC) sdkkonnectops.DeleteHmacAuthWithConsumerRequest {
+	return sdkkonnectops.DeleteHmacAuthWithConsumerRequest{
+		ControlPlaneID:            ent.Status.Konnect.ControlPlaneID,
+		ConsumerIDForNestedEntity: ent.Status.Konnect.ConsumerID,
+		HMACAuthID:                ent.Status.Konnect.GetKonnectID(),
+	}
+}
+
+func kongCredentialHMACToSDK(ent *configurationv1alpha1.KongCredentialHMAC) sdkkonnectcomp.HMACAuthWithoutParents {
+	return sdkkonnectcomp.HMACAuthWithoutParents{
+		Username: sdkkonnectgo.String(ent.Spec.Username),
+		Secret:   sdkkonnectgo.String(ent.Spec.Secret),
+		Tags:     GenerateKubernetesMetadataTags(ent),
+	}
+}
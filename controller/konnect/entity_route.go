@@ -0,0 +1,49 @@
+package konnect
+
+import (
+	"context"
+
+	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+)
+
+// createRoute creates a KongRoute in Konnect.
+// It sets the KonnectID in the KongRoute status.
+func createRoute(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1alpha1.KongRoute) error {
+	resp, err := sdk.Routes.CreateRoute(ctx, ent.Status.Konnect.ControlPlaneID, kongRouteToSDKRouteInput(ent))
+	if errWrap := wrapErrIfKonnectOpFailed[configurationv1alpha1.KongRoute, *configurationv1alpha1.KongRoute](err, CreateOp, ent); errWrap != nil {
+		return errWrap
+	}
+
+	ent.Status.Konnect.SetKonnectID(*resp.Route.ID)
+	return nil
+}
+
+// updateRoute updates a KongRoute in Konnect.
+// The Konnect ID of the KongRoute must be set on its Status.
+func updateRoute(ctx context.Context, sdk *sdkkonnectgo.SDK, cl client.Client, ent *configurationv1alpha1.KongRoute) error {
+	_, err := sdk.Routes.UpsertRoute(ctx, sdkkonnectops.UpsertRouteRequest{
+		ControlPlaneID: ent.Status.Konnect.ControlPlaneID,
+		RouteID:        ent.Status.Konnect.GetKonnectID(),
+		Route:          kongRouteToSDKRouteInput(ent),
+	})
+	return wrapErrIfKonnectOpFailed[configurationv1alpha1.KongRoute, *configurationv1alpha1.KongRoute](err, UpdateOp, ent)
+}
+
+// deleteRoute deletes a KongRoute in Konnect.
+// The Konnect ID of the KongRoute must be set on its Status.
+func deleteRoute(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1alpha1.KongRoute) error {
+	_, err := sdk.Routes.DeleteRoute(ctx, ent.Status.Konnect.ControlPlaneID, ent.Status.Konnect.GetKonnectID())
+	return wrapErrIfKonnectOpFailed[configurationv1alpha1.KongRoute, *configurationv1alpha1.KongRoute](err, DeleteOp, ent)
+}
+
+func kongRouteToSDKRouteInput(ent *configurationv1alpha1.KongRoute) sdkkonnectcomp.Route {
+	return sdkkonnectcomp.Route{
+		Name: sdkkonnectgo.String(ent.Spec.Name),
+		Tags: GenerateKubernetesMetadataTags(ent),
+	}
+}
@@ -0,0 +1,49 @@
+package konnect
+
+import (
+	"context"
+
+	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+)
+
+// createService creates a KongService in Konnect.
+// It sets the KonnectID in the KongService status.
+func createService(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1alpha1.KongService) error {
+	resp, err := sdk.Services.CreateService(ctx, ent.Status.Konnect.ControlPlaneID, kongServiceToSDKServiceInput(ent))
+	if errWrap := wrapErrIfKonnectOpFailed[configurationv1alpha1.KongService, *configurationv1alpha1.KongService](err, CreateOp, ent); errWrap != nil {
+		return errWrap
+	}
+
+	ent.Status.Konnect.SetKonnectID(*resp.Service.ID)
+	return nil
+}
+
+// updateService updates a KongService in Konnect.
+// The Konnect ID of the KongService must be set on its Status.
+func updateService(ctx context.Context, sdk *sdkkonnectgo.SDK, cl client.Client, ent *configurationv1alpha1.KongService) error {
+	_, err := sdk.Services.UpsertService(ctx, sdkkonnectops.UpsertServiceRequest{
+		ControlPlaneID: ent.Status.Konnect.ControlPlaneID,
+		ServiceID:      ent.Status.Konnect.GetKonnectID(),
+		Service:        kongServiceToSDKServiceInput(ent),
+	})
+	return wrapErrIfKonnectOpFailed[configurationv1alpha1.KongService, *configurationv1alpha1.KongService](err, UpdateOp, ent)
+}
+
+// deleteService deletes a KongService in Konnect.
+// The Konnect ID of the KongService must be set on its Status.
+func deleteService(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1alpha1.KongService) error {
+	_, err := sdk.Services.DeleteService(ctx, ent.Status.Konnect.ControlPlaneID, ent.Status.Konnect.GetKonnectID())
+	return wrapErrIfKonnectOpFailed[configurationv1alpha1.KongService, *configurationv1alpha1.KongService](err, DeleteOp, ent)
+}
+
+func kongServiceToSDKServiceInput(ent *configurationv1alpha1.KongService) sdkkonnectcomp.Service {
+	return sdkkonnectcomp.Service{
+		Name: sdkkonnectgo.String(ent.Spec.Name),
+		Tags: GenerateKubernetesMetadataTags(ent),
+	}
+}
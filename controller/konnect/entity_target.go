@@ -0,0 +1,67 @@
+package konnect
+
+import (
+	"context"
+
+	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+)
+
+// createTarget creates a KongTarget in Konnect, under the KongUpstream
+// referenced by ent.Spec.UpstreamRef.
+// It sets the KonnectID in the KongTarget status.
+func createTarget(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1alpha1.KongTarget) error {
+	resp, err := sdk.Targets.CreateTargetWithUpstream(ctx, sdkkonnectops.CreateTargetWithUpstreamRequest{
+		ControlPlaneID: ent.Status.Konnect.ControlPlaneID,
+		UpstreamID:     ent.Status.Konnect.UpstreamID,
+		Target:         kongTargetToSDKTargetInput(ent),
+	})
+	if errWrap := wrapErrIfKonnectOpFailed[configurationv1alpha1.KongTarget, *configurationv1alpha1.KongTarget](err, CreateOp, ent); errWrap != nil {
+		return errWrap
+	}
+
+	ent.Status.Konnect.SetKonnectID(*resp.Target.ID)
+	return nil
+}
+
+// updateTarget updates a KongTarget in Konnect.
+// The Konnect ID of the KongTarget must be set on its Status.
+func updateTarget(ctx context.Context, sdk *sdkkonnectgo.SDK, cl client.Client, ent *configurationv1alpha1.KongTarget) error {
+	_, err := sdk.Targets.UpsertTargetWithUpstream(ctx, sdkkonnectops.UpsertTargetWithUpstreamRequest{
+		ControlPlaneID: ent.Status.Konnect.ControlPlaneID,
+		UpstreamID:     ent.Status.Konnect.UpstreamID,
+		TargetID:       ent.Status.Konnect.GetKonnectID(),
+		Target:         kongTargetToSDKTargetInput(ent),
+	})
+	return wrapErrIfKonnectOpFailed[configurationv1alpha1.KongTarget, *configurationv1alpha1.KongTarget](err, UpdateOp, ent)
+}
+
+// deleteTarget deletes a KongTarget in Konnect.
+// The Konnect ID of the KongTarget must be set on its Status.
+//
+// Callers MUST NOT invoke this when the owning KongUpstream has already
+// been removed from Konnect: deleting the upstream cascades and removes the
+// target there already, so issuing a Delete call here would just fail with
+// a 404. Delete checks for that case with handleTargetsForDeletedUpstream
+// (reconciler_upstreamref.go) before calling this, and in that case clears
+// the KongTarget's Konnect ID and returns nil without calling Konnect.
+func deleteTarget(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1alpha1.KongTarget) error {
+	_, err := sdk.Targets.DeleteTargetWithUpstream(ctx, sdkkonnectops.DeleteTargetWithUpstreamRequest{
+		ControlPlaneID: ent.Status.Konnect.ControlPlaneID,
+		UpstreamID:     ent.Status.Konnect.UpstreamID,
+		TargetID:       ent.Status.Konnect.GetKonnectID(),
+	})
+	return wrapErrIfKonnectOpFailed[configurationv1alpha1.KongTarget, *configurationv1alpha1.KongTarget](err, DeleteOp, ent)
+}
+
+func kongTargetToSDKTargetInput(ent *configurationv1alpha1.KongTarget) sdkkonnectcomp.Target {
+	return sdkkonnectcomp.Target{
+		Target: sdkkonnectgo.String(ent.Spec.Target),
+		Weight: sdkkonnectgo.Int64(int64(ent.Spec.Weight)),
+		Tags:   GenerateKubernetesMetadataTags(ent),
+	}
+}
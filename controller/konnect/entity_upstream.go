@@ -0,0 +1,52 @@
+package konnect
+
+import (
+	"context"
+
+	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+	sdkkonnectcomp "github.com/Kong/sdk-konnect-go/models/components"
+	sdkkonnectops "github.com/Kong/sdk-konnect-go/models/operations"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+)
+
+// createUpstream creates a KongUpstream in Konnect.
+// It sets the KonnectID in the KongUpstream status.
+func createUpstream(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1alpha1.KongUpstream) error {
+	resp, err := sdk.Upstreams.CreateUpstream(ctx, ent.Status.Konnect.ControlPlaneID, kongUpstreamToSDKUpstreamInput(ent))
+	if errWrap := wrapErrIfKonnectOpFailed[configurationv1alpha1.KongUpstream, *configurationv1alpha1.KongUpstream](err, CreateOp, ent); errWrap != nil {
+		return errWrap
+	}
+
+	ent.Status.Konnect.SetKonnectID(*resp.Upstream.ID)
+	return nil
+}
+
+// updateUpstream updates a KongUpstream in Konnect.
+// The Konnect ID of the KongUpstream must be set on its Status.
+func updateUpstream(ctx context.Context, sdk *sdkkonnectgo.SDK, cl client.Client, ent *configurationv1alpha1.KongUpstream) error {
+	_, err := sdk.Upstreams.UpsertUpstream(ctx, sdkkonnectops.UpsertUpstreamRequest{
+		ControlPlaneID: ent.Status.Konnect.ControlPlaneID,
+		UpstreamID:     ent.Status.Konnect.GetKonnectID(),
+		Upstream:       kongUpstreamToSDKUpstreamInput(ent),
+	})
+	return wrapErrIfKonnectOpFailed[configurationv1alpha1.KongUpstream, *configurationv1alpha1.KongUpstream](err, UpdateOp, ent)
+}
+
+// deleteUpstream deletes a KongUpstream in Konnect.
+// The Konnect ID of the KongUpstream must be set on its Status.
+// Deleting the upstream in Konnect cascades and deletes all its KongTargets,
+// so KongTarget reconcilers must not issue their own Delete call once that
+// has happened; see deleteTarget.
+func deleteUpstream(ctx context.Context, sdk *sdkkonnectgo.SDK, ent *configurationv1alpha1.KongUpstream) error {
+	_, err := sdk.Upstreams.DeleteUpstream(ctx, ent.Status.Konnect.ControlPlaneID, ent.Status.Konnect.GetKonnectID())
+	return wrapErrIfKonnectOpFailed[configurationv1alpha1.KongUpstream, *configurationv1alpha1.KongUpstream](err, DeleteOp, ent)
+}
+
+func kongUpstreamToSDKUpstreamInput(ent *configurationv1alpha1.KongUpstream) sdkkonnectcomp.Upstream {
+	return sdkkonnectcomp.Upstream{
+		Name: ent.Spec.Name,
+		Tags: GenerateKubernetesMetadataTags(ent),
+	}
+}
@@ -2,10 +2,12 @@ package konnect
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+	sdkkonnecterrs "github.com/Kong/sdk-konnect-go/models/sdkerrors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,24 +40,90 @@ const (
 	DeleteOp Op = "delete"
 )
 
+// ErrConflict is returned (unwrapped) from Create when the Konnect API
+// reports that the entity already exists (HTTP 409) and the
+// adopt-on-conflict fallback that follows cannot resolve it. When the
+// fallback finds a match it stores the resolved Konnect ID directly on the
+// entity via SetKonnectID and Create returns nil instead.
+type ErrConflict struct {
+	// Body is the error returned by the adopt List call, or a message saying
+	// that no matching entity was found. The original Create error is not
+	// kept.
+	Body string
+}
+
+func (e *ErrConflict) Error() string {
+	return fmt.Sprintf("entity already exists in Konnect: %s", e.Body)
+}
+
+// isConflictErr returns true if err is the error returned by the Konnect SDK
+// when a Create call fails because the entity already exists (HTTP 409).
+func isConflictErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	var sdkErr *sdkkonnecterrs.SDKError
+	if errors.As(err, &sdkErr) {
+		return sdkErr.StatusCode == 409
+	}
+	return false
+}
+
 // Create creates a Konnect entity.
+// For KonnectControlPlane, KongService, KongRoute, KongConsumer,
+// KongConsumerGroup and KongUpstream, if the Konnect API reports that the
+// entity already exists (HTTP 409), it falls back to listing Konnect
+// entities of the matching type, filtered by e's k8s-uid tag (label, for
+// KonnectControlPlane), and adopts the first one whose k8s-uid matches e's
+// UID by storing its Konnect ID on e instead of failing the reconcile.
+// KongTarget and the consumer credential types have no such fallback: a 409
+// is returned as an error.
 func Create[
 	T SupportedKonnectEntityType,
 	TEnt EntityType[T],
 ](ctx context.Context, sdk *sdkkonnectgo.SDK, cl client.Client, e *T) (*T, error) {
 	defer logOpComplete[T, TEnt](ctx, time.Now(), CreateOp, e)
 
+	var err error
 	switch ent := any(e).(type) {
 	case *konnectv1alpha1.KonnectControlPlane:
-		return e, createControlPlane(ctx, sdk, ent)
+		err = createControlPlane(ctx, sdk, ent)
+		if isConflictErr(err) {
+			err = adoptControlPlane(ctx, sdk, ent)
+		}
 	case *configurationv1alpha1.KongService:
-		return e, createService(ctx, sdk, ent)
+		err = createService(ctx, sdk, ent)
+		if isConflictErr(err) {
+			err = adoptService(ctx, sdk, ent)
+		}
 	case *configurationv1alpha1.KongRoute:
-		return e, createRoute(ctx, sdk, ent)
+		err = createRoute(ctx, sdk, ent)
+		if isConflictErr(err) {
+			err = adoptRoute(ctx, sdk, ent)
+		}
 	case *configurationv1.KongConsumer:
-		return e, createConsumer(ctx, sdk, ent)
+		err = createConsumer(ctx, sdk, ent)
+		if isConflictErr(err) {
+			err = adoptConsumer(ctx, sdk, ent)
+		}
 	case *configurationv1beta1.KongConsumerGroup:
-		return e, createConsumerGroup(ctx, sdk, ent)
+		err = createConsumerGroup(ctx, sdk, ent)
+		if isConflictErr(err) {
+			err = adoptConsumerGroup(ctx, sdk, ent)
+		}
+	case *configurationv1alpha1.KongUpstream:
+		err = createUpstream(ctx, sdk, ent)
+		if isConflictErr(err) {
+			err = adoptUpstream(ctx, sdk, ent)
+		}
+	case *configurationv1alpha1.KongTarget:
+		err = createTarget(ctx, sdk, ent)
+	case *configurationv1alpha1.KongCredentialBasicAuth:
+		err = createCredentialBasicAuth(ctx, sdk, cl, ent)
+	case *configurationv1alpha1.KongCredentialAPIKey:
+		err = createCredentialAPIKey(ctx, sdk, cl, ent)
+	case *configurationv1alpha1.KongCredentialHMAC:
+		err = createCredentialHMAC(ctx, sdk, cl, ent)
 
 		// ---------------------------------------------------------------------
 		// TODO: add other Konnect types
@@ -63,15 +131,26 @@ func Create[
 	default:
 		return nil, fmt.Errorf("unsupported entity type %T", ent)
 	}
+
+	return e, err
 }
 
 // Delete deletes a Konnect entity.
 // It returns an error if the entity does not have a Konnect ID or if the operation fails.
+// It refuses to delete an entity that still carries KongPluginInUseFinalizer,
+// so that a KongService/KongRoute/KongConsumer/KongConsumerGroup/KongUpstream
+// referenced by a KongPluginBinding can't be removed out from under it.
 func Delete[
 	T SupportedKonnectEntityType,
 	TEnt EntityType[T],
 ](ctx context.Context, sdk *sdkkonnectgo.SDK, cl client.Client, e *T) error {
 	ent := TEnt(e)
+	if HasPluginInUseFinalizer(ent) {
+		return fmt.Errorf(
+			"can't delete %T %s while it is still referenced by a KongPluginBinding (%s finalizer present)",
+			ent, client.ObjectKeyFromObject(ent), KongPluginInUseFinalizer,
+		)
+	}
 	if ent.GetKonnectStatus().GetKonnectID() == "" {
 		return fmt.Errorf(
 			"can't delete %T %s when it does not have the Konnect ID",
@@ -92,6 +171,23 @@ func Delete[
 		return deleteConsumer(ctx, sdk, ent)
 	case *configurationv1beta1.KongConsumerGroup:
 		return deleteConsumerGroup(ctx, sdk, ent)
+	case *configurationv1alpha1.KongUpstream:
+		return deleteUpstream(ctx, sdk, ent)
+	case *configurationv1alpha1.KongTarget:
+		handled, err := handleTargetsForDeletedUpstream(ctx, cl, ent)
+		if err != nil {
+			return err
+		}
+		if handled {
+			return nil
+		}
+		return deleteTarget(ctx, sdk, ent)
+	case *configurationv1alpha1.KongCredentialBasicAuth:
+		return deleteCredentialBasicAuth(ctx, sdk, ent)
+	case *configurationv1alpha1.KongCredentialAPIKey:
+		return deleteCredentialAPIKey(ctx, sdk, ent)
+	case *configurationv1alpha1.KongCredentialHMAC:
+		return deleteCredentialHMAC(ctx, sdk, ent)
 
 		// ---------------------------------------------------------------------
 		// TODO: add other Konnect types
@@ -106,21 +202,23 @@ func Delete[
 func Update[
 	T SupportedKonnectEntityType,
 	TEnt EntityType[T],
-](ctx context.Context, sdk *sdkkonnectgo.SDK, syncPeriod time.Duration, cl client.Client, e *T) (ctrl.Result, error) {
+](ctx context.Context, sdk *sdkkonnectgo.SDK, syncPolicy SyncPolicy, cl client.Client, e *T) (ctrl.Result, error) {
 	var (
 		ent                = TEnt(e)
 		condProgrammed, ok = k8sutils.GetCondition(KonnectEntityProgrammedConditionType, ent)
 		now                = time.Now()
 		timeFromLastUpdate = time.Since(condProgrammed.LastTransitionTime.Time)
+		typeName           = entityTypeName[T]()
 	)
 	// If the entity is already programmed and the last update was less than
-	// the configured sync period, requeue after the remaining time.
+	// the effective per-kind sync period, requeue after the remaining time
+	// (shortened by the policy's jitter) instead of calling Konnect.
 	if ok &&
 		condProgrammed.Status == metav1.ConditionTrue &&
 		condProgrammed.Reason == KonnectEntityProgrammedReasonProgrammed &&
 		condProgrammed.ObservedGeneration == ent.GetObjectMeta().GetGeneration() &&
-		timeFromLastUpdate <= syncPeriod {
-		requeueAfter := syncPeriod - timeFromLastUpdate
+		timeFromLastUpdate <= syncPolicy.PeriodFor(typeName) {
+		requeueAfter := syncPolicy.RequeueAfter(typeName, timeFromLastUpdate)
 		log.Debug(ctrllog.FromContext(ctx),
 			"no need for update, requeueing after configured sync period", e,
 			"last_update", condProgrammed.LastTransitionTime.Time,
@@ -153,6 +251,16 @@ func Update[
 		return ctrl.Result{}, updateConsumer(ctx, sdk, cl, ent)
 	case *configurationv1beta1.KongConsumerGroup:
 		return ctrl.Result{}, updateConsumerGroup(ctx, sdk, cl, ent)
+	case *configurationv1alpha1.KongUpstream:
+		return ctrl.Result{}, updateUpstream(ctx, sdk, cl, ent)
+	case *configurationv1alpha1.KongTarget:
+		return ctrl.Result{}, updateTarget(ctx, sdk, cl, ent)
+	case *configurationv1alpha1.KongCredentialBasicAuth:
+		return ctrl.Result{}, updateCredentialBasicAuth(ctx, sdk, cl, ent)
+	case *configurationv1alpha1.KongCredentialAPIKey:
+		return ctrl.Result{}, updateCredentialAPIKey(ctx, sdk, cl, ent)
+	case *configurationv1alpha1.KongCredentialHMAC:
+		return ctrl.Result{}, updateCredentialHMAC(ctx, sdk, cl, ent)
 
 		// ---------------------------------------------------------------------
 		// TODO: add other Konnect types
@@ -0,0 +1,66 @@
+package konnect
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+)
+
+// consumerRefTarget is the minimal surface handleKongConsumerRef needs from
+// a consumer credential entity (KongCredentialBasicAuth, APIKey, HMAC) to
+// set the KongConsumerRefValid condition on it.
+type consumerRefTarget interface {
+	client.Object
+	GetConditions() []metav1.Condition
+	SetConditions([]metav1.Condition)
+}
+
+// handleKongConsumerRef resolves the KongConsumer named consumerRefName in
+// namespace, sets the KongConsumerRefValid condition on ent to reflect
+// whether the reference is valid, and, when it is, returns the parent
+// KongConsumer's Konnect ID so the caller can populate it as the
+// credential's Status.Konnect.ConsumerID before issuing the Create call.
+func handleKongConsumerRef(
+	ctx context.Context,
+	cl client.Client,
+	ent consumerRefTarget,
+	namespace, consumerRefName string,
+) (consumerID string, err error) {
+	nn := client.ObjectKey{Namespace: namespace, Name: consumerRefName}
+
+	var consumer configurationv1.KongConsumer
+	if err := cl.Get(ctx, nn, &consumer); err != nil {
+		setKongConsumerRefCondition(ent, metav1.ConditionFalse, KongConsumerRefReasonInvalid,
+			fmt.Sprintf("referenced KongConsumer %s not found: %s", nn, err))
+		return "", err
+	}
+
+	consumerID = consumer.Status.Konnect.GetKonnectID()
+	if consumerID == "" {
+		err := fmt.Errorf("KongConsumer %s has no Konnect ID yet", nn)
+		setKongConsumerRefCondition(ent, metav1.ConditionFalse, KongConsumerRefReasonInvalid, err.Error())
+		return "", err
+	}
+
+	setKongConsumerRefCondition(ent, metav1.ConditionTrue, KongConsumerRefReasonValid, "")
+	return consumerID, nil
+}
+
+func setKongConsumerRefCondition(ent consumerRefTarget, status metav1.ConditionStatus, reason, message string) {
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(
+			KongConsumerRefValidConditionType,
+			status,
+			reason,
+			message,
+			ent.GetGeneration(),
+		),
+		ent,
+	)
+}
@@ -0,0 +1,215 @@
+package konnect
+
+import (
+	"context"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+)
+
+// KongPluginInUseFinalizer is set on a KongService, KongRoute, KongConsumer,
+// KongConsumerGroup or KongUpstream while at least one KongPluginBinding
+// references it, so that the entity cannot be deleted from Konnect out from
+// under the plugin binding. It is added and removed exclusively by
+// KonnectEntityPluginBindingFinalizerReconciler.
+const KongPluginInUseFinalizer = "gateway-operator.konghq.com/plugin-in-use"
+
+// KonnectEntityPluginBindingFinalizerReconciler watches KongPluginBindings
+// and ensures that KongPluginInUseFinalizer is present on the entity of type
+// T they target for as long as at least one KongPluginBinding references
+// it, and removed once the last such reference goes away.
+type KonnectEntityPluginBindingFinalizerReconciler[
+	T SupportedKonnectEntityType,
+	TEnt EntityType[T],
+] struct {
+	Client client.Client
+}
+
+// NewKonnectEntityPluginBindingFinalizerReconciler returns a new
+// KonnectEntityPluginBindingFinalizerReconciler for the given entity type.
+func NewKonnectEntityPluginBindingFinalizerReconciler[
+	T SupportedKonnectEntityType,
+	TEnt EntityType[T],
+](cl client.Client) *KonnectEntityPluginBindingFinalizerReconciler[T, TEnt] {
+	return &KonnectEntityPluginBindingFinalizerReconciler[T, TEnt]{
+		Client: cl,
+	}
+}
+
+// Reconcile implements reconcile.Reconciler. req refers to the target entity
+// (KongService, KongRoute, etc.), not the KongPluginBinding itself, so that
+// changes to any of the bindings referencing the same target are coalesced
+// into a single reconcile of that target.
+func (r *KonnectEntityPluginBindingFinalizerReconciler[T, TEnt]) Reconcile(
+	ctx context.Context, req ctrl.Request,
+) (ctrl.Result, error) {
+	var obj T
+	ent := TEnt(&obj)
+	if err := r.Client.Get(ctx, req.NamespacedName, ent); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	inUse, err := r.referencedByPluginBinding(ctx, ent)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	changed := setFinalizerPresence(ent, KongPluginInUseFinalizer, inUse)
+	if !changed {
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.Client.Update(ctx, ent); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update finalizers on %T %s: %w", ent, req.NamespacedName, err)
+	}
+	return ctrl.Result{}, nil
+}
+
+// referencedByPluginBinding returns true if at least one KongPluginBinding
+// in ent's namespace targets ent. This counts every KongPluginBinding in the
+// namespace: KongPluginBinding does not currently expose a managed/unmanaged
+// distinction anywhere else in this codebase to scope the check to, so an
+// operator-created binding pins the finalizer exactly like a user-created
+// one.
+func (r *KonnectEntityPluginBindingFinalizerReconciler[T, TEnt]) referencedByPluginBinding(
+	ctx context.Context, ent TEnt,
+) (bool, error) {
+	var bindings configurationv1alpha1.KongPluginBindingList
+	if err := r.Client.List(ctx, &bindings, client.InNamespace(ent.GetNamespace())); err != nil {
+		return false, fmt.Errorf("failed to list KongPluginBindings: %w", err)
+	}
+
+	for i := range bindings.Items {
+		if pluginBindingTargets(&bindings.Items[i], ent) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// pluginBindingTargets reports whether b's targets reference ent.
+func pluginBindingTargets(b *configurationv1alpha1.KongPluginBinding, ent client.Object) bool {
+	targets := b.Spec.Targets
+	switch {
+	case targets.ServiceReference != nil:
+		return targets.ServiceReference.Name == ent.GetName()
+	case targets.RouteReference != nil:
+		return targets.RouteReference.Name == ent.GetName()
+	case targets.ConsumerReference != nil:
+		return targets.ConsumerReference.Name == ent.GetName()
+	case targets.ConsumerGroupReference != nil:
+		return targets.ConsumerGroupReference.Name == ent.GetName()
+	case targets.UpstreamReference != nil:
+		return targets.UpstreamReference.Name == ent.GetName()
+	default:
+		return false
+	}
+}
+
+// setFinalizerPresence adds finalizer to ent if present is true and it is
+// missing, or removes it if present is false and it is set. It returns true
+// if ent's finalizers were changed.
+func setFinalizerPresence(ent client.Object, finalizer string, present bool) bool {
+	existing := ent.GetFinalizers()
+	has := false
+	filtered := make([]string, 0, len(existing))
+	for _, f := range existing {
+		if f == finalizer {
+			has = true
+			continue
+		}
+		filtered = append(filtered, f)
+	}
+
+	switch {
+	case present && !has:
+		ent.SetFinalizers(append(existing, finalizer))
+		return true
+	case !present && has:
+		ent.SetFinalizers(filtered)
+		return true
+	default:
+		return false
+	}
+}
+
+// HasPluginInUseFinalizer reports whether obj still carries
+// KongPluginInUseFinalizer. Entity reconcilers must check this before
+// calling konnect.Delete on entities that can be plugin binding targets
+// (KongService, KongRoute, KongConsumer, KongConsumerGroup, KongUpstream)
+// and requeue instead of deleting while it is still present.
+func HasPluginInUseFinalizer(obj client.Object) bool {
+	for _, f := range obj.GetFinalizers() {
+		if f == KongPluginInUseFinalizer {
+			return true
+		}
+	}
+	return false
+}
+
+// enqueueObjectReferencedByKongPluginBinding returns a handler.MapFunc that,
+// given a KongPluginBinding event, enqueues a reconcile.Request for the
+// entity that the binding targets (if any), so that adding or removing a
+// KongPluginBinding immediately updates the finalizer on its target.
+func enqueueObjectReferencedByKongPluginBinding(namespace string) handler.MapFunc {
+	return func(_ context.Context, obj client.Object) []reconcile.Request {
+		b, ok := obj.(*configurationv1alpha1.KongPluginBinding)
+		if !ok {
+			return nil
+		}
+
+		targets := b.Spec.Targets
+		name := ""
+		switch {
+		case targets.ServiceReference != nil:
+			name = targets.ServiceReference.Name
+		case targets.RouteReference != nil:
+			name = targets.RouteReference.Name
+		case targets.ConsumerReference != nil:
+			name = targets.ConsumerReference.Name
+		case targets.ConsumerGroupReference != nil:
+			name = targets.ConsumerGroupReference.Name
+		case targets.UpstreamReference != nil:
+			name = targets.UpstreamReference.Name
+		default:
+			return nil
+		}
+
+		return []reconcile.Request{{
+			NamespacedName: types.NamespacedName{Namespace: namespace, Name: name},
+		}}
+	}
+}
+
+// ControllerManagedByManager registers the
+// KonnectEntityPluginBindingFinalizerReconciler for entity type T with mgr.
+func ControllerManagedByManager[
+	T SupportedKonnectEntityType,
+	TEnt EntityType[T],
+](mgr manager.Manager) error {
+	var empty T
+	ent := TEnt(&empty)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(ent).
+		Watches(
+			&configurationv1alpha1.KongPluginBinding{},
+			handler.EnqueueRequestsFromMapFunc(
+				func(ctx context.Context, obj client.Object) []reconcile.Request {
+					return enqueueObjectReferencedByKongPluginBinding(obj.GetNamespace())(ctx, obj)
+				},
+			),
+		).
+		Complete(NewKonnectEntityPluginBindingFinalizerReconciler[T, TEnt](mgr.GetClient()))
+}
@@ -0,0 +1,97 @@
+package konnect
+
+import (
+	"context"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+)
+
+// resolveKongTargetUpstreamRef resolves the KongUpstream referenced by
+// target.Spec.UpstreamRef, the same way ControlPlaneRefValid and
+// KongServiceRefValid references are resolved for other entities: it looks
+// the referenced object up, sets the KongUpstreamRefValid condition to
+// reflect whether the reference is valid, and returns the resolved
+// KongUpstream.
+func resolveKongTargetUpstreamRef(
+	ctx context.Context,
+	cl client.Client,
+	target *configurationv1alpha1.KongTarget,
+) (*configurationv1alpha1.KongUpstream, error) {
+	nn := client.ObjectKey{
+		Namespace: target.Namespace,
+		Name:      target.Spec.UpstreamRef.Name,
+	}
+
+	var upstream configurationv1alpha1.KongUpstream
+	if err := cl.Get(ctx, nn, &upstream); err != nil {
+		setKongUpstreamRefInvalidCondition(target, fmt.Sprintf("referenced KongUpstream %s not found: %s", nn, err))
+		return nil, err
+	}
+
+	setKongUpstreamRefValidCondition(target)
+	return &upstream, nil
+}
+
+func setKongUpstreamRefValidCondition(target *configurationv1alpha1.KongTarget) {
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(
+			KongUpstreamRefValidConditionType,
+			metav1.ConditionTrue,
+			KongUpstreamRefReasonValid,
+			"",
+			target.GetGeneration(),
+		),
+		target,
+	)
+}
+
+func setKongUpstreamRefInvalidCondition(target *configurationv1alpha1.KongTarget, message string) {
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(
+			KongUpstreamRefValidConditionType,
+			metav1.ConditionFalse,
+			KongUpstreamRefReasonInvalid,
+			message,
+			target.GetGeneration(),
+		),
+		target,
+	)
+}
+
+// handleTargetsForDeletedUpstream is invoked by Delete for a KongTarget
+// before it calls deleteTarget. Deleting a KongUpstream in Konnect cascades
+// and removes all of its KongTargets, so once the owning KongUpstream is
+// gone there is nothing left to delete: a Delete call would just fail. In
+// that case this clears the KongTarget's Konnect ID and returns true, and
+// Delete returns nil without issuing a Delete call, so the caller can remove
+// the finalizer. It returns false, nil when the upstream still exists so the
+// regular delete path is followed, and false with the error on any other
+// lookup error.
+func handleTargetsForDeletedUpstream(
+	ctx context.Context,
+	cl client.Client,
+	target *configurationv1alpha1.KongTarget,
+) (handled bool, err error) {
+	nn := client.ObjectKey{
+		Namespace: target.Namespace,
+		Name:      target.Spec.UpstreamRef.Name,
+	}
+
+	var upstream configurationv1alpha1.KongUpstream
+	err = cl.Get(ctx, nn, &upstream)
+	switch {
+	case err == nil:
+		return false, nil
+	case k8serrors.IsNotFound(err):
+		target.Status.Konnect.SetKonnectID("")
+		return true, nil
+	default:
+		return false, err
+	}
+}
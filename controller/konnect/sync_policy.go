@@ -0,0 +1,140 @@
+package konnect
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// defaultSyncPeriod is used for any entity type that doesn't have a
+// per-kind override configured in a SyncPolicy.
+const defaultSyncPeriod = 1 * time.Minute
+
+// SyncPolicy controls how often konnect.Update re-syncs an already
+// programmed entity with Konnect, and how that interval is randomized to
+// avoid many entities refreshing in lockstep (a thundering herd against the
+// Konnect API when hundreds of objects were programmed around the same
+// time).
+type SyncPolicy struct {
+	// Default is the sync period used for entity types without an entry in
+	// PerKind.
+	Default time.Duration
+	// PerKind overrides Default for specific entity type names, as returned
+	// by entityTypeName. For example PerKind["KonnectControlPlane"] = 5 *
+	// time.Minute, PerKind["KongRoute"] = 1 * time.Minute.
+	PerKind map[string]time.Duration
+	// JitterFactor is the fraction (0.0-1.0) of the effective sync period
+	// that is randomly subtracted from the requeue interval, so that
+	// requeues for entities programmed at the same time spread out over
+	// time instead of firing together.
+	JitterFactor float64
+
+	// perKindFlags holds the pointers BindFlags binds its
+	// --konnect-sync-period-<kind> flags to. They're resolved lazily by
+	// PeriodFor (once flag.Parse has run) instead of being copied into
+	// PerKind at registration time, since at registration time the flags
+	// haven't been parsed yet and still hold their zero value.
+	perKindFlags map[string]*time.Duration
+}
+
+// NewDefaultSyncPolicy returns a SyncPolicy with the package default sync
+// period, no per-kind overrides, and no jitter.
+func NewDefaultSyncPolicy() SyncPolicy {
+	return SyncPolicy{
+		Default: defaultSyncPeriod,
+		PerKind: map[string]time.Duration{},
+	}
+}
+
+// PeriodFor returns the effective sync period for the given entity type
+// name, applying a --konnect-sync-period-<kind> flag bound by BindFlags, or
+// else a PerKind override, if one is configured.
+func (p SyncPolicy) PeriodFor(typeName string) time.Duration {
+	if ptr, ok := p.perKindFlags[typeName]; ok && *ptr > 0 {
+		return *ptr
+	}
+	if d, ok := p.PerKind[typeName]; ok {
+		return d
+	}
+	if p.Default > 0 {
+		return p.Default
+	}
+	return defaultSyncPeriod
+}
+
+// RequeueAfter returns the (jittered) duration to wait before the next
+// Update call for an entity whose Programmed condition was last set
+// timeSinceLastUpdate ago, for the given entity type name. It returns 0 (no
+// requeue needed yet having elapsed) once timeSinceLastUpdate has passed
+// the effective period.
+func (p SyncPolicy) RequeueAfter(typeName string, timeSinceLastUpdate time.Duration) time.Duration {
+	period := p.PeriodFor(typeName)
+	remaining := period - timeSinceLastUpdate
+	if remaining <= 0 {
+		return 0
+	}
+	return remaining - p.jitter(remaining)
+}
+
+// jitter returns a random duration in [0, JitterFactor*d), clamping
+// JitterFactor to [0, 1].
+func (p SyncPolicy) jitter(d time.Duration) time.Duration {
+	factor := p.JitterFactor
+	switch {
+	case factor <= 0:
+		return 0
+	case factor > 1:
+		factor = 1
+	}
+	//nolint:gosec // jitter does not need to be cryptographically secure.
+	return time.Duration(rand.Float64() * factor * float64(d))
+}
+
+// syncPeriodFlagKinds lists the entity type names that get their own
+// --konnect-sync-period-<kind> flag. Kept in sync with SupportedKonnectEntityType.
+var syncPeriodFlagKinds = []string{
+	"KonnectControlPlane",
+	"KongService",
+	"KongRoute",
+	"KongConsumer",
+	"KongConsumerGroup",
+	"KongUpstream",
+	"KongTarget",
+	"KongCredentialBasicAuth",
+	"KongCredentialAPIKey",
+	"KongCredentialHMAC",
+}
+
+// BindFlags registers --konnect-sync-period (the Default) and one
+// --konnect-sync-period-<kind> flag per supported entity type (lowercased,
+// e.g. --konnect-sync-period-kongservice) on fs, plus
+// --konnect-sync-period-jitter-factor. Call it from the manager's flag
+// setup before parsing os.Args.
+func (p *SyncPolicy) BindFlags(fs *flag.FlagSet) {
+	fs.DurationVar(&p.Default, "konnect-sync-period", defaultSyncPeriod,
+		"Default interval at which programmed Konnect entities are re-synced.")
+	fs.Float64Var(&p.JitterFactor, "konnect-sync-period-jitter-factor", 0,
+		"Fraction (0.0-1.0) of the effective sync period to randomly subtract from each requeue, to avoid a thundering herd against Konnect.")
+
+	if p.perKindFlags == nil {
+		p.perKindFlags = make(map[string]*time.Duration, len(syncPeriodFlagKinds))
+	}
+	for _, kind := range syncPeriodFlagKinds {
+		d := new(time.Duration)
+		fs.DurationVar(d, fmt.Sprintf("konnect-sync-period-%s", flagNameForKind(kind)), 0,
+			fmt.Sprintf("Sync period override for %s; defaults to --konnect-sync-period when unset or zero.", kind))
+		p.perKindFlags[kind] = d
+	}
+}
+
+func flagNameForKind(kind string) string {
+	out := make([]byte, 0, len(kind))
+	for _, r := range kind {
+		if r >= 'A' && r <= 'Z' {
+			r = r - 'A' + 'a'
+		}
+		out = append(out, byte(r))
+	}
+	return string(out)
+}
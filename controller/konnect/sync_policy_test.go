@@ -0,0 +1,85 @@
+package konnect
+
+import (
+	"context"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sutils "github.com/kong/gateway-operator/pkg/utils/kubernetes"
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+)
+
+func TestSyncPolicy_PeriodFor(t *testing.T) {
+	p := SyncPolicy{
+		Default: 1 * time.Minute,
+		PerKind: map[string]time.Duration{
+			"KonnectControlPlane": 5 * time.Minute,
+		},
+	}
+
+	assert.Equal(t, 5*time.Minute, p.PeriodFor("KonnectControlPlane"),
+		"a configured PerKind override must take precedence over Default")
+	assert.Equal(t, 1*time.Minute, p.PeriodFor("KongRoute"),
+		"an entity type without a PerKind entry must fall back to Default")
+}
+
+func TestSyncPolicy_RequeueAfter_JitterBounded(t *testing.T) {
+	p := SyncPolicy{Default: 1 * time.Minute, JitterFactor: 0.5}
+
+	for i := 0; i < 100; i++ {
+		d := p.RequeueAfter("KongService", 0)
+		require.GreaterOrEqual(t, d, time.Duration(0))
+		require.LessOrEqual(t, d, p.PeriodFor("KongService"))
+	}
+}
+
+func TestSyncPolicy_RequeueAfter_ElapsedReturnsZero(t *testing.T) {
+	p := SyncPolicy{Default: 1 * time.Minute}
+	assert.Equal(t, time.Duration(0), p.RequeueAfter("KongService", 2*time.Minute))
+}
+
+func TestSyncPolicy_BindFlags(t *testing.T) {
+	p := &SyncPolicy{}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	p.BindFlags(fs)
+
+	require.NoError(t, fs.Parse([]string{"-konnect-sync-period-kongservice=30s"}))
+
+	assert.Equal(t, 30*time.Second, p.PeriodFor("KongService"),
+		"an explicitly set --konnect-sync-period-<kind> flag must override the default once flags are parsed")
+	assert.Equal(t, defaultSyncPeriod, p.PeriodFor("KongRoute"),
+		"a per-kind flag that was never set must not collapse to zero")
+}
+
+// TestUpdate_AlreadyProgrammedFastPathSkipsSDKCall asserts that Update
+// doesn't reach the SDK dispatch switch (which would nil-pointer dereference
+// the nil *sdkkonnectgo.SDK passed below) when the entity's Programmed
+// condition is already true, current for its generation, and within the
+// effective sync period.
+func TestUpdate_AlreadyProgrammedFastPathSkipsSDKCall(t *testing.T) {
+	svc := &configurationv1alpha1.KongService{}
+	svc.Status.Konnect.SetKonnectID("service-1")
+	k8sutils.SetCondition(
+		k8sutils.NewConditionWithGeneration(
+			KonnectEntityProgrammedConditionType,
+			metav1.ConditionTrue,
+			KonnectEntityProgrammedReasonProgrammed,
+			"",
+			svc.GetGeneration(),
+		),
+		svc,
+	)
+
+	policy := SyncPolicy{Default: 1 * time.Minute}
+	result, err := Update[configurationv1alpha1.KongService, *configurationv1alpha1.KongService](
+		context.Background(), nil, policy, nil, svc,
+	)
+	require.NoError(t, err)
+	assert.Greater(t, result.RequeueAfter, time.Duration(0))
+	assert.LessOrEqual(t, result.RequeueAfter, policy.Default)
+}
@@ -0,0 +1,131 @@
+package konnect
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// k8sTagName is the tag/label key holding the source object's name.
+	k8sTagName = "k8s-name"
+	// k8sTagNamespace is the tag/label key holding the source object's namespace.
+	k8sTagNamespace = "k8s-namespace"
+	// k8sTagUID is the tag/label key holding the source object's UID.
+	k8sTagUID = "k8s-uid"
+	// k8sTagGeneration is the tag/label key holding the source object's generation.
+	k8sTagGeneration = "k8s-generation"
+	// k8sTagKind is the tag/label key holding the source object's kind.
+	k8sTagKind = "k8s-kind"
+	// k8sTagGroup is the tag/label key holding the source object's API group.
+	k8sTagGroup = "k8s-group"
+	// k8sTagVersion is the tag/label key holding the source object's API version.
+	k8sTagVersion = "k8s-version"
+)
+
+// kubernetesMetadataKV returns the Kubernetes-origin key/value pairs that
+// are attached to every Konnect entity created or updated by the operator,
+// so that entities in Konnect can always be traced back to the Kubernetes
+// object that owns them.
+func kubernetesMetadataKV(obj client.Object) [][2]string {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	return [][2]string{
+		{k8sTagName, obj.GetName()},
+		{k8sTagNamespace, obj.GetNamespace()},
+		{k8sTagUID, string(obj.GetUID())},
+		{k8sTagGeneration, strconv.FormatInt(obj.GetGeneration(), 10)},
+		{k8sTagKind, gvk.Kind},
+		{k8sTagGroup, gvk.Group},
+		{k8sTagVersion, gvk.Version},
+	}
+}
+
+// GenerateKubernetesMetadataTags generates the list of Konnect tags that
+// should be set on entities which do not support labels (KongService,
+// KongRoute, KongConsumer, KongConsumerGroup, KongUpstream, KongTarget and
+// the consumer credential types), in the "key:value" format expected by the
+// Konnect API.
+func GenerateKubernetesMetadataTags(obj client.Object) []string {
+	kv := kubernetesMetadataKV(obj)
+	tags := make([]string, 0, len(kv))
+	for _, p := range kv {
+		tags = append(tags, fmt.Sprintf("%s:%s", p[0], p[1]))
+	}
+	return tags
+}
+
+// GenerateKubernetesMetadataLabels generates the map of Konnect labels that
+// should be set on entities which support labels (KonnectControlPlane).
+func GenerateKubernetesMetadataLabels(obj client.Object) map[string]string {
+	kv := kubernetesMetadataKV(obj)
+	labels := make(map[string]string, len(kv))
+	for _, p := range kv {
+		labels[p[0]] = p[1]
+	}
+	return labels
+}
+
+// NamespacedNameFromTags parses a list of Konnect tags generated by
+// GenerateKubernetesMetadataTags and reconstructs the types.NamespacedName
+// of the Kubernetes object they originated from. It returns false if the
+// tags don't contain a k8s-name and k8s-namespace pair.
+func NamespacedNameFromTags(tags []string) (types.NamespacedName, bool) {
+	var nn types.NamespacedName
+	var haveName, haveNamespace bool
+	for _, t := range tags {
+		k, v, ok := strings.Cut(t, ":")
+		if !ok {
+			continue
+		}
+		switch k {
+		case k8sTagName:
+			nn.Name = v
+			haveName = true
+		case k8sTagNamespace:
+			nn.Namespace = v
+			haveNamespace = true
+		}
+	}
+	return nn, haveName && haveNamespace
+}
+
+// stableIdentityTag returns the single Konnect tag ("k8s-uid:<uid>") that
+// permanently identifies the Kubernetes object owning a Konnect entity.
+// Unlike the full set produced by GenerateKubernetesMetadataTags, it
+// deliberately excludes k8s-generation: that value changes on every spec
+// edit, so filtering an adopt lookup on the full tag set stops matching a
+// pre-existing (orphaned) remote entity as soon as the owning object is
+// updated once. Use this for the List filter in the adopt* helpers.
+func stableIdentityTag(obj client.Object) string {
+	return fmt.Sprintf("%s:%s", k8sTagUID, obj.GetUID())
+}
+
+// stableIdentityLabels is the label-based equivalent of stableIdentityTag,
+// for entities that are matched by Konnect labels instead of tags (e.g.
+// KonnectControlPlane).
+func stableIdentityLabels(obj client.Object) map[string]string {
+	return map[string]string{k8sTagUID: string(obj.GetUID())}
+}
+
+// UIDFromTags parses a list of Konnect tags generated by
+// GenerateKubernetesMetadataTags and returns the k8s-uid tag value, if
+// present.
+func UIDFromTags(tags []string) (string, bool) {
+	for _, t := range tags {
+		k, v, ok := strings.Cut(t, ":")
+		if ok && k == k8sTagUID {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// UIDFromLabels returns the k8s-uid label value from labels generated by
+// GenerateKubernetesMetadataLabels, if present.
+func UIDFromLabels(labels map[string]string) (string, bool) {
+	v, ok := labels[k8sTagUID]
+	return v, ok
+}
@@ -0,0 +1,105 @@
+package konnect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	configurationv1beta1 "github.com/kong/kubernetes-configuration/api/configuration/v1beta1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+func TestGenerateKubernetesMetadataTags(t *testing.T) {
+	om := metav1.ObjectMeta{
+		Name:       "the-name",
+		Namespace:  "the-namespace",
+		UID:        "the-uid",
+		Generation: 3,
+	}
+
+	testCases := []struct {
+		name string
+		obj  client.Object
+	}{
+		{
+			name: "KongService",
+			obj:  &configurationv1alpha1.KongService{ObjectMeta: om},
+		},
+		{
+			name: "KongRoute",
+			obj:  &configurationv1alpha1.KongRoute{ObjectMeta: om},
+		},
+		{
+			name: "KongConsumer",
+			obj:  &configurationv1.KongConsumer{ObjectMeta: om},
+		},
+		{
+			name: "KongConsumerGroup",
+			obj:  &configurationv1beta1.KongConsumerGroup{ObjectMeta: om},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tags := GenerateKubernetesMetadataTags(tc.obj)
+			assert.Contains(t, tags, "k8s-name:the-name")
+			assert.Contains(t, tags, "k8s-namespace:the-namespace")
+			assert.Contains(t, tags, "k8s-uid:the-uid")
+			assert.Contains(t, tags, "k8s-generation:3")
+
+			nn, ok := NamespacedNameFromTags(tags)
+			require.True(t, ok)
+			assert.Equal(t, types.NamespacedName{Namespace: "the-namespace", Name: "the-name"}, nn)
+
+			uid, ok := UIDFromTags(tags)
+			require.True(t, ok)
+			assert.Equal(t, "the-uid", uid)
+		})
+	}
+}
+
+func TestGenerateKubernetesMetadataLabels(t *testing.T) {
+	cp := &konnectv1alpha1.KonnectControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "the-name",
+			Namespace:  "the-namespace",
+			UID:        "the-uid",
+			Generation: 1,
+		},
+	}
+
+	labels := GenerateKubernetesMetadataLabels(cp)
+	assert.Equal(t, "the-name", labels["k8s-name"])
+	assert.Equal(t, "the-namespace", labels["k8s-namespace"])
+	assert.Equal(t, "the-uid", labels["k8s-uid"])
+
+	uid, ok := UIDFromLabels(labels)
+	require.True(t, ok)
+	assert.Equal(t, "the-uid", uid)
+}
+
+func TestNamespacedNameFromTags_Incomplete(t *testing.T) {
+	_, ok := NamespacedNameFromTags([]string{"k8s-name:only-name"})
+	assert.False(t, ok)
+}
+
+func TestStableIdentityTagAndLabels(t *testing.T) {
+	om := metav1.ObjectMeta{
+		Name:       "the-name",
+		Namespace:  "the-namespace",
+		UID:        "the-uid",
+		Generation: 3,
+	}
+	svc := &configurationv1alpha1.KongService{ObjectMeta: om}
+
+	assert.Equal(t, "k8s-uid:the-uid", stableIdentityTag(svc))
+
+	cp := &konnectv1alpha1.KonnectControlPlane{ObjectMeta: om}
+	assert.Equal(t, map[string]string{"k8s-uid": "the-uid"}, stableIdentityLabels(cp))
+}
@@ -0,0 +1,133 @@
+package envtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+)
+
+// ScriptedResponse is one canned response for a single call to a given
+// Konnect API method+path. Body is marshaled as JSON; an empty Body with a
+// non-2xx Status still produces a response (used for bare error responses).
+type ScriptedResponse struct {
+	Status int
+	Body   any
+	Delay  time.Duration
+}
+
+// ResponseScript is a gomock-in-spirit fake of the Konnect API: instead of
+// mocking the generated SDK's internal HTTP transport directly (its clients
+// are concrete structs, not interfaces, so they aren't mockgen targets), it
+// runs a local httptest.Server and lets a test queue up the exact sequence
+// of responses each method+path should return — including a 409 Conflict
+// followed by the List response the adopt-on-conflict fallback in
+// controller/konnect.Create should see, a transient 5xx, or a response
+// delayed to simulate a slow Konnect.
+type ResponseScript struct {
+	mu     sync.Mutex
+	server *httptest.Server
+	queues map[string][]ScriptedResponse
+	calls  map[string]int
+}
+
+// NewResponseScript starts the backing httptest.Server and returns an empty
+// ResponseScript ready to be programmed with On().
+func NewResponseScript() *ResponseScript {
+	rs := &ResponseScript{
+		queues: map[string][]ScriptedResponse{},
+		calls:  map[string]int{},
+	}
+	rs.server = httptest.NewServer(http.HandlerFunc(rs.handle))
+	return rs
+}
+
+// Calls returns how many requests method+path has received so far. Tests
+// use it to assert a call did (or, e.g. for the sync-period fast path,
+// deliberately did not) happen, when RequireDrained's unconsumed-queue
+// check isn't precise enough (e.g. when only one response was ever
+// scripted for that key, so the queue never runs out to compare against).
+func (rs *ResponseScript) Calls(method, path string) int {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return rs.calls[scriptKey(method, path)]
+}
+
+// Close tears down the backing httptest.Server. Tests should register it
+// with t.Cleanup.
+func (rs *ResponseScript) Close() {
+	rs.server.Close()
+}
+
+// On queues resp to be returned the next time method+path is called. Calls
+// to the same method+path are served in the order they were queued; once
+// the queue is empty the last queued response keeps being replayed.
+func (rs *ResponseScript) On(method, path string, resp ScriptedResponse) *ResponseScript {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	key := scriptKey(method, path)
+	rs.queues[key] = append(rs.queues[key], resp)
+	return rs
+}
+
+// SDK returns an *sdkkonnectgo.SDK pointed at this ResponseScript's backing
+// server, so that calls made through it are served by whatever was
+// programmed with On().
+func (rs *ResponseScript) SDK() *sdkkonnectgo.SDK {
+	return sdkkonnectgo.New(sdkkonnectgo.WithServerURL(rs.server.URL))
+}
+
+func (rs *ResponseScript) handle(w http.ResponseWriter, r *http.Request) {
+	key := scriptKey(r.Method, r.URL.Path)
+
+	rs.mu.Lock()
+	rs.calls[key]++
+	queue := rs.queues[key]
+	var resp ScriptedResponse
+	if len(queue) > 0 {
+		resp = queue[0]
+		if len(queue) > 1 {
+			rs.queues[key] = queue[1:]
+		}
+	} else {
+		resp = ScriptedResponse{Status: http.StatusNotFound, Body: map[string]string{
+			"message": fmt.Sprintf("no response scripted for %s", key),
+		}}
+	}
+	rs.mu.Unlock()
+
+	if resp.Delay > 0 {
+		time.Sleep(resp.Delay)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(resp.Status)
+	if resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+func scriptKey(method, path string) string {
+	return method + " " + path
+}
+
+// RequireDrained fails t if any response that was scripted with On has not
+// been consumed, catching tests that over-program a script for a flow that
+// didn't end up exercising every call they expected. The last response
+// queued for each method+path is kept for replay and is never reported, so
+// it is not flagged even if it was never served; use Calls to check that.
+func RequireDrained(t *testing.T, rs *ResponseScript) {
+	t.Helper()
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	for key, queue := range rs.queues {
+		if len(queue) > 1 {
+			t.Errorf("response script for %q has %d unconsumed responses queued", key, len(queue)-1)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package envtest
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseScript_ConflictThenList(t *testing.T) {
+	rs := NewResponseScript()
+	t.Cleanup(rs.Close)
+
+	rs.On(http.MethodPost, "/v2/control-planes/cp-1/core-entities/services",
+		ScriptedResponse{Status: http.StatusConflict, Body: map[string]string{"message": "already exists"}})
+	rs.On(http.MethodGet, "/v2/control-planes/cp-1/core-entities/services",
+		ScriptedResponse{Status: http.StatusOK, Body: map[string]any{
+			"data": []map[string]string{{"id": "svc-existing"}},
+		}})
+
+	sdk := rs.SDK()
+	require.NotNil(t, sdk)
+
+	resp1, err := http.Post(rs.server.URL+"/v2/control-planes/cp-1/core-entities/services", "application/json", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, resp1.StatusCode)
+
+	resp2, err := http.Get(rs.server.URL + "/v2/control-planes/cp-1/core-entities/services")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp2.StatusCode)
+
+	RequireDrained(t, rs)
+}
+
+func TestResponseScript_TransientErrorThenSuccess(t *testing.T) {
+	rs := NewResponseScript()
+	t.Cleanup(rs.Close)
+
+	rs.On(http.MethodPost, "/v2/control-planes/cp-1/core-entities/routes",
+		ScriptedResponse{Status: http.StatusBadGateway, Body: map[string]string{"message": "upstream hiccup"}})
+	rs.On(http.MethodPost, "/v2/control-planes/cp-1/core-entities/routes",
+		ScriptedResponse{Status: http.StatusOK, Body: map[string]string{"id": "route-1"}})
+
+	resp1, err := http.Post(rs.server.URL+"/v2/control-planes/cp-1/core-entities/routes", "application/json", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadGateway, resp1.StatusCode)
+
+	resp2, err := http.Post(rs.server.URL+"/v2/control-planes/cp-1/core-entities/routes", "application/json", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp2.StatusCode)
+}
+
+func TestResponseScript_Calls(t *testing.T) {
+	rs := NewResponseScript()
+	t.Cleanup(rs.Close)
+
+	rs.On(http.MethodPost, "/v2/control-planes/cp-1/core-entities/services",
+		ScriptedResponse{Status: http.StatusOK, Body: map[string]string{"id": "svc-1"}})
+
+	assert.Equal(t, 0, rs.Calls(http.MethodPost, "/v2/control-planes/cp-1/core-entities/services"))
+
+	_, err := http.Post(rs.server.URL+"/v2/control-planes/cp-1/core-entities/services", "application/json", nil)
+	require.NoError(t, err)
+	_, err = http.Post(rs.server.URL+"/v2/control-planes/cp-1/core-entities/services", "application/json", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, rs.Calls(http.MethodPost, "/v2/control-planes/cp-1/core-entities/services"))
+	assert.Equal(t, 0, rs.Calls(http.MethodGet, "/v2/control-planes/cp-1/core-entities/services"),
+		"a different method for the same path must be tracked separately")
+}
+
+func TestResponseScript_Delay(t *testing.T) {
+	rs := NewResponseScript()
+	t.Cleanup(rs.Close)
+
+	rs.On(http.MethodGet, "/v2/control-planes/cp-1/core-entities/consumers",
+		ScriptedResponse{Status: http.StatusOK, Body: map[string]string{"id": "consumer-1"}, Delay: 50 * time.Millisecond})
+
+	start := time.Now()
+	resp, err := http.Get(rs.server.URL + "/v2/control-planes/cp-1/core-entities/consumers")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
@@ -0,0 +1,353 @@
+package envtest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/gateway-operator/controller/konnect"
+
+	configurationv1 "github.com/kong/kubernetes-configuration/api/configuration/v1"
+	configurationv1alpha1 "github.com/kong/kubernetes-configuration/api/configuration/v1alpha1"
+	configurationv1beta1 "github.com/kong/kubernetes-configuration/api/configuration/v1beta1"
+	konnectv1alpha1 "github.com/kong/kubernetes-configuration/api/konnect/v1alpha1"
+)
+
+// TestSupportedEntityTypes_PersistInEnvtest is the sample test for
+// KongService, KongRoute, KongConsumer, KongConsumerGroup and
+// KonnectControlPlane: it asserts each CR can be created against the
+// envtest API server and read back, i.e. the CRDs this harness gives every
+// Konnect reconciler test to build on are registered correctly. Their calls
+// against Konnect are dispatched by controller/konnect.Create/Update/Delete
+// through a real controller loop; see TestKongUpstream_Create,
+// TestKongService_ConflictAdopt,
+// TestKongRoute_SyncPeriodFastPathSkipsSecondKonnectCall,
+// TestKonnectControlPlane_Create, TestKongConsumer_Create and
+// TestKongConsumerGroup_Create below for that.
+func TestSupportedEntityTypes_PersistInEnvtest(t *testing.T) {
+	Run(t, func(t *testing.T, env *Environment) {
+		ctx := context.Background()
+		ns := CreateNamespace(ctx, t, env.Client)
+		auth := DeployKonnectAPIAuthConfiguration(ctx, t, env.Client, ns)
+		cp := DeployKonnectControlPlane(ctx, t, env.Client, ns, auth)
+
+		t.Run("KongService", func(t *testing.T) {
+			svc := &configurationv1alpha1.KongService{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns, GenerateName: "svc-"},
+			}
+			require.NoError(t, env.Client.Create(ctx, svc))
+			require.NoError(t, env.Client.Get(ctx, client.ObjectKeyFromObject(svc), svc))
+		})
+
+		t.Run("KongRoute", func(t *testing.T) {
+			route := &configurationv1alpha1.KongRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns, GenerateName: "route-"},
+			}
+			require.NoError(t, env.Client.Create(ctx, route))
+			require.NoError(t, env.Client.Get(ctx, client.ObjectKeyFromObject(route), route))
+		})
+
+		t.Run("KongConsumer", func(t *testing.T) {
+			consumer := &configurationv1.KongConsumer{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns, GenerateName: "consumer-"},
+			}
+			require.NoError(t, env.Client.Create(ctx, consumer))
+			require.NoError(t, env.Client.Get(ctx, client.ObjectKeyFromObject(consumer), consumer))
+		})
+
+		t.Run("KongConsumerGroup", func(t *testing.T) {
+			cg := &configurationv1beta1.KongConsumerGroup{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns, GenerateName: "cg-"},
+			}
+			require.NoError(t, env.Client.Create(ctx, cg))
+			require.NoError(t, env.Client.Get(ctx, client.ObjectKeyFromObject(cg), cg))
+		})
+
+		t.Run("KonnectControlPlane", func(t *testing.T) {
+			require.NoError(t, env.Client.Get(ctx, client.ObjectKeyFromObject(cp), cp))
+		})
+	})
+}
+
+// TestKongUpstream_Create exercises the full Create dispatch in
+// controller/konnect end-to-end: it registers an entityReconciler backed by
+// the FakeSDKFactory with env.Manager, creates a KongUpstream against the
+// envtest API server, and asserts the real controller loop drives it to
+// Programmed=true by actually calling the scripted Konnect endpoint -
+// nothing in the test body calls konnect.Create itself.
+func TestKongUpstream_Create(t *testing.T) {
+	Run(t, func(t *testing.T, env *Environment) {
+		ctx := context.Background()
+		ns := CreateNamespace(ctx, t, env.Client)
+
+		env.SDKFactory.Responses.On(http.MethodPost, "/v2/control-planes/cp-1/core-entities/upstreams",
+			ScriptedResponse{Status: http.StatusOK, Body: map[string]any{"id": "upstream-1"}})
+
+		sdk := env.SDKFactory.NewKonnectSDK(env.SDKFactory.Responses.server.URL, "test-token")
+		RegisterEntityReconciler[configurationv1alpha1.KongUpstream, *configurationv1alpha1.KongUpstream](
+			t, env, sdk, konnect.NewDefaultSyncPolicy(),
+		)
+		StartManager(ctx, t, env)
+
+		upstream := &configurationv1alpha1.KongUpstream{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, GenerateName: "upstream-"},
+			Spec:       configurationv1alpha1.KongUpstreamSpec{Name: "test-upstream"},
+		}
+		require.NoError(t, env.Client.Create(ctx, upstream))
+		upstream.Status.Konnect.ControlPlaneID = "cp-1"
+		require.NoError(t, env.Client.Status().Update(ctx, upstream))
+
+		EventuallyMeetConditions(ctx, t, env.Client, upstream, konnect.KonnectEntityProgrammedConditionType)
+		require.Equal(t, "upstream-1", upstream.Status.Konnect.GetKonnectID())
+	})
+}
+
+// TestKongService_ConflictAdopt exercises the adopt-on-conflict fallback
+// (controller/konnect's adoptService) end-to-end through the controller
+// loop: the scripted Create call returns 409, which must drive the
+// reconciler to list Konnect by the service's k8s-uid tag and adopt the
+// pre-existing entity's ID instead of failing the reconcile forever.
+func TestKongService_ConflictAdopt(t *testing.T) {
+	Run(t, func(t *testing.T, env *Environment) {
+		ctx := context.Background()
+		ns := CreateNamespace(ctx, t, env.Client)
+
+		svc := &configurationv1alpha1.KongService{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, GenerateName: "svc-"},
+		}
+		require.NoError(t, env.Client.Create(ctx, svc))
+
+		env.SDKFactory.Responses.On(http.MethodPost, "/v2/control-planes/cp-1/core-entities/services",
+			ScriptedResponse{Status: http.StatusConflict, Body: map[string]string{"message": "already exists"}})
+		// The adopt lookup filters on the service's own k8s-uid tag, so the
+		// scripted List response must echo it back for adoptService's
+		// client-side UID match to succeed.
+		env.SDKFactory.Responses.On(http.MethodGet, "/v2/control-planes/cp-1/core-entities/services",
+			ScriptedResponse{Status: http.StatusOK, Body: map[string]any{
+				"data": []map[string]any{{
+					"id":   "svc-existing",
+					"tags": []string{fmt.Sprintf("k8s-uid:%s", svc.UID)},
+				}},
+			}})
+
+		sdk := env.SDKFactory.NewKonnectSDK(env.SDKFactory.Responses.server.URL, "test-token")
+		RegisterEntityReconciler[configurationv1alpha1.KongService, *configurationv1alpha1.KongService](
+			t, env, sdk, konnect.NewDefaultSyncPolicy(),
+		)
+		StartManager(ctx, t, env)
+
+		svc.Status.Konnect.ControlPlaneID = "cp-1"
+		require.NoError(t, env.Client.Status().Update(ctx, svc))
+
+		EventuallyMeetConditions(ctx, t, env.Client, svc, konnect.KonnectEntityProgrammedConditionType)
+		require.Equal(t, "svc-existing", svc.Status.Konnect.GetKonnectID())
+	})
+}
+
+// TestKongRoute_SyncPeriodFastPathSkipsSecondKonnectCall exercises the
+// sync-period requeue logic in konnect.Update end-to-end: once a KongRoute
+// is Programmed, triggering a second reconcile within the configured sync
+// period (here 1 minute) must not call Konnect again. The Create endpoint's
+// call count, asserted via ResponseScript.Calls before and after the second
+// reconcile, must stay at 1 throughout.
+func TestKongRoute_SyncPeriodFastPathSkipsSecondKonnectCall(t *testing.T) {
+	Run(t, func(t *testing.T, env *Environment) {
+		ctx := context.Background()
+		ns := CreateNamespace(ctx, t, env.Client)
+
+		env.SDKFactory.Responses.On(http.MethodPost, "/v2/control-planes/cp-1/core-entities/routes",
+			ScriptedResponse{Status: http.StatusOK, Body: map[string]any{"id": "route-1"}})
+
+		sdk := env.SDKFactory.NewKonnectSDK(env.SDKFactory.Responses.server.URL, "test-token")
+		RegisterEntityReconciler[configurationv1alpha1.KongRoute, *configurationv1alpha1.KongRoute](
+			t, env, sdk, konnect.SyncPolicy{Default: time.Minute},
+		)
+		StartManager(ctx, t, env)
+
+		route := &configurationv1alpha1.KongRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, GenerateName: "route-"},
+		}
+		require.NoError(t, env.Client.Create(ctx, route))
+		route.Status.Konnect.ControlPlaneID = "cp-1"
+		require.NoError(t, env.Client.Status().Update(ctx, route))
+
+		EventuallyMeetConditions(ctx, t, env.Client, route, konnect.KonnectEntityProgrammedConditionType)
+		require.Equal(t, 1, env.SDKFactory.Responses.Calls(http.MethodPost, "/v2/control-planes/cp-1/core-entities/routes"))
+
+		// Trigger a second reconcile without bumping the generation (a spec
+		// edit would legitimately require a fresh Konnect call). The fast
+		// path in konnect.Update must recognize the entity is already
+		// Programmed, within its sync period, and at its observed
+		// generation, and skip calling Konnect entirely.
+		require.NoError(t, env.Client.Get(ctx, client.ObjectKeyFromObject(route), route))
+		route.Labels = map[string]string{"envtest.gateway-operator.konghq.com/touch": "1"}
+		require.NoError(t, env.Client.Update(ctx, route))
+
+		require.Eventually(t, func() bool {
+			require.NoError(t, env.Client.Get(ctx, client.ObjectKeyFromObject(route), route))
+			return route.Labels["envtest.gateway-operator.konghq.com/touch"] == "1"
+		}, 5*time.Second, 50*time.Millisecond)
+
+		// Give the controller a moment to process the label-only update
+		// before asserting no extra Konnect call was made.
+		time.Sleep(500 * time.Millisecond)
+		require.Equal(t, 1, env.SDKFactory.Responses.Calls(http.MethodPost, "/v2/control-planes/cp-1/core-entities/routes"),
+			"the sync-period fast path must not re-call Konnect for a reconcile within the sync period")
+		require.Equal(t, 0, env.SDKFactory.Responses.Calls(http.MethodPut, "/v2/control-planes/cp-1/core-entities/routes/route-1"),
+			"the sync-period fast path must not issue an Update call to Konnect within the sync period")
+	})
+}
+
+// TestKonnectControlPlane_Create exercises Create dispatch for
+// KonnectControlPlane end-to-end, the same way TestKongUpstream_Create
+// does for KongUpstream. This is the type controller/konnect actually
+// dispatches on (see SupportedKonnectEntityType), unlike the unrelated
+// KonnectGatewayControlPlane type.
+func TestKonnectControlPlane_Create(t *testing.T) {
+	Run(t, func(t *testing.T, env *Environment) {
+		ctx := context.Background()
+		ns := CreateNamespace(ctx, t, env.Client)
+		auth := DeployKonnectAPIAuthConfiguration(ctx, t, env.Client, ns)
+
+		env.SDKFactory.Responses.On(http.MethodPost, "/v2/control-planes",
+			ScriptedResponse{Status: http.StatusOK, Body: map[string]string{"id": "cp-remote-1"}})
+
+		sdk := env.SDKFactory.NewKonnectSDK(env.SDKFactory.Responses.server.URL, "test-token")
+		RegisterEntityReconciler[konnectv1alpha1.KonnectControlPlane, *konnectv1alpha1.KonnectControlPlane](
+			t, env, sdk, konnect.NewDefaultSyncPolicy(),
+		)
+		StartManager(ctx, t, env)
+
+		cp := &konnectv1alpha1.KonnectControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, GenerateName: "cp-"},
+		}
+		cp.Spec.APIAuthConfigurationRef.Name = auth.Name
+		require.NoError(t, env.Client.Create(ctx, cp))
+
+		EventuallyMeetConditions(ctx, t, env.Client, cp, konnect.KonnectEntityProgrammedConditionType)
+		require.Equal(t, "cp-remote-1", cp.Status.Konnect.GetKonnectID())
+	})
+}
+
+// TestKongConsumer_Create exercises Create dispatch for
+// KongConsumer end-to-end, the same way TestKongUpstream_Create
+// does for KongUpstream.
+func TestKongConsumer_Create(t *testing.T) {
+	Run(t, func(t *testing.T, env *Environment) {
+		ctx := context.Background()
+		ns := CreateNamespace(ctx, t, env.Client)
+
+		env.SDKFactory.Responses.On(http.MethodPost, "/v2/control-planes/cp-1/core-entities/consumers",
+			ScriptedResponse{Status: http.StatusOK, Body: map[string]any{"id": "consumer-1"}})
+
+		sdk := env.SDKFactory.NewKonnectSDK(env.SDKFactory.Responses.server.URL, "test-token")
+		RegisterEntityReconciler[configurationv1.KongConsumer, *configurationv1.KongConsumer](
+			t, env, sdk, konnect.NewDefaultSyncPolicy(),
+		)
+		StartManager(ctx, t, env)
+
+		consumer := &configurationv1.KongConsumer{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, GenerateName: "consumer-"},
+			Username:   "test-consumer",
+		}
+		require.NoError(t, env.Client.Create(ctx, consumer))
+		consumer.Status.Konnect.ControlPlaneID = "cp-1"
+		require.NoError(t, env.Client.Status().Update(ctx, consumer))
+
+		EventuallyMeetConditions(ctx, t, env.Client, consumer, konnect.KonnectEntityProgrammedConditionType)
+		require.Equal(t, "consumer-1", consumer.Status.Konnect.GetKonnectID())
+	})
+}
+
+// TestKongConsumerGroup_Create exercises Create dispatch for
+// KongConsumerGroup end-to-end, the same way TestKongUpstream_Create
+// does for KongUpstream.
+func TestKongConsumerGroup_Create(t *testing.T) {
+	Run(t, func(t *testing.T, env *Environment) {
+		ctx := context.Background()
+		ns := CreateNamespace(ctx, t, env.Client)
+
+		env.SDKFactory.Responses.On(http.MethodPost, "/v2/control-planes/cp-1/core-entities/consumer-groups",
+			ScriptedResponse{Status: http.StatusOK, Body: map[string]any{
+				"consumer_group": map[string]string{"id": "cg-1"},
+			}})
+
+		sdk := env.SDKFactory.NewKonnectSDK(env.SDKFactory.Responses.server.URL, "test-token")
+		RegisterEntityReconciler[configurationv1beta1.KongConsumerGroup, *configurationv1beta1.KongConsumerGroup](
+			t, env, sdk, konnect.NewDefaultSyncPolicy(),
+		)
+		StartManager(ctx, t, env)
+
+		cg := &configurationv1beta1.KongConsumerGroup{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, GenerateName: "cg-"},
+		}
+		require.NoError(t, env.Client.Create(ctx, cg))
+		cg.Status.Konnect.ControlPlaneID = "cp-1"
+		require.NoError(t, env.Client.Status().Update(ctx, cg))
+
+		EventuallyMeetConditions(ctx, t, env.Client, cg, konnect.KonnectEntityProgrammedConditionType)
+		require.Equal(t, "cg-1", cg.Status.Konnect.GetKonnectID())
+	})
+}
+
+// TestKongTarget_DeleteSkipsWhenUpstreamGone exercises the cascade-delete
+// case handleTargetsForDeletedUpstream exists for: once the KongUpstream a
+// KongTarget belongs to is gone, Konnect has already cascade-deleted the
+// target along with it, so ops.Delete must skip calling deleteTarget
+// entirely instead of issuing a Delete call Konnect would 404 on. The
+// target's delete-target endpoint is deliberately left unscripted here - if
+// the cascade-delete case isn't honored, the entityReconciler would call it,
+// get ResponseScript's synthetic 404, and never clear the finalizer, so the
+// object would never disappear.
+func TestKongTarget_DeleteSkipsWhenUpstreamGone(t *testing.T) {
+	Run(t, func(t *testing.T, env *Environment) {
+		ctx := context.Background()
+		ns := CreateNamespace(ctx, t, env.Client)
+
+		upstream := &configurationv1alpha1.KongUpstream{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, GenerateName: "upstream-"},
+			Spec:       configurationv1alpha1.KongUpstreamSpec{Name: "test-upstream"},
+		}
+		require.NoError(t, env.Client.Create(ctx, upstream))
+
+		env.SDKFactory.Responses.On(http.MethodPost, "/v2/control-planes/cp-1/core-entities/upstreams/upstream-1/targets",
+			ScriptedResponse{Status: http.StatusOK, Body: map[string]any{"id": "target-1"}})
+
+		sdk := env.SDKFactory.NewKonnectSDK(env.SDKFactory.Responses.server.URL, "test-token")
+		RegisterEntityReconciler[configurationv1alpha1.KongTarget, *configurationv1alpha1.KongTarget](
+			t, env, sdk, konnect.NewDefaultSyncPolicy(),
+		)
+		StartManager(ctx, t, env)
+
+		target := &configurationv1alpha1.KongTarget{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, GenerateName: "target-"},
+			Spec:       configurationv1alpha1.KongTargetSpec{Target: "10.0.0.1:8000"},
+		}
+		target.Spec.UpstreamRef.Name = upstream.Name
+		require.NoError(t, env.Client.Create(ctx, target))
+		target.Status.Konnect.ControlPlaneID = "cp-1"
+		target.Status.Konnect.UpstreamID = "upstream-1"
+		require.NoError(t, env.Client.Status().Update(ctx, target))
+
+		EventuallyMeetConditions(ctx, t, env.Client, target, konnect.KonnectEntityProgrammedConditionType)
+		require.Equal(t, "target-1", target.Status.Konnect.GetKonnectID())
+
+		// upstream was never registered with an entityReconciler, so it has
+		// no finalizer and deleting it removes it from the API server
+		// immediately - exactly like Konnect cascade-deleting it remotely.
+		require.NoError(t, env.Client.Delete(ctx, upstream))
+
+		require.NoError(t, env.Client.Delete(ctx, target))
+		require.Eventually(t, func() bool {
+			err := env.Client.Get(ctx, client.ObjectKeyFromObject(target), target)
+			return k8serrors.IsNotFound(err)
+		}, 5*time.Second, 50*time.Millisecond, "KongTarget should be removed without a Delete call to Konnect")
+	})
+}
@@ -0,0 +1,40 @@
+package envtest
+
+import (
+	"sync"
+
+	sdkkonnectgo "github.com/Kong/sdk-konnect-go"
+)
+
+// SDKFactory abstracts the construction of the Konnect SDK client so that
+// reconcilers under test can be handed a fake SDK instead of one that talks
+// to the real Konnect API. Production code has a corresponding factory that
+// builds an *sdkkonnectgo.SDK from a KonnectAPIAuthConfiguration's token.
+type SDKFactory interface {
+	NewKonnectSDK(serverURL string, token string) *sdkkonnectgo.SDK
+}
+
+// FakeSDKFactory is an SDKFactory whose returned SDKs talk to the local
+// ResponseScript server programmed per test via Responses, so tests can
+// script exact Konnect API behavior (409 Conflict + List pairs, transient
+// 5xx, slow responses) without a real Konnect backend.
+type FakeSDKFactory struct {
+	mu        sync.Mutex
+	Responses *ResponseScript
+}
+
+// NewFakeSDKFactory returns a FakeSDKFactory with an empty ResponseScript
+// ready to be programmed by a test.
+func NewFakeSDKFactory() *FakeSDKFactory {
+	return &FakeSDKFactory{
+		Responses: NewResponseScript(),
+	}
+}
+
+// NewKonnectSDK implements SDKFactory. It ignores serverURL and token: the
+// returned SDK's per-endpoint behavior is entirely driven by f.Responses.
+func (f *FakeSDKFactory) NewKonnectSDK(_ string, _ string) *sdkkonnectgo.SDK {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.Responses.SDK()
+}